@@ -284,6 +284,29 @@ func BenchmarkSqrt(bench *testing.B) {
 	bench.Run("single/big", benchmarkBig)
 }
 
+func BenchmarkRoot(b *testing.B) {
+	benchmarkRoot := func(b *testing.B, f func(z, x *Int) *Int) {
+		var z Int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			f(&z, &int256Samples[i%numSamples])
+		}
+	}
+	b.Run("SqrtRem", func(b *testing.B) {
+		var r Int
+		benchmarkRoot(b, func(z, x *Int) *Int { z.SqrtRem(x, &r); return z })
+	})
+	b.Run("SqrtCeil", func(b *testing.B) { benchmarkRoot(b, (*Int).SqrtCeil) })
+	b.Run("Cbrt", func(b *testing.B) { benchmarkRoot(b, (*Int).Cbrt) })
+	b.Run("Root5", func(b *testing.B) { benchmarkRoot(b, func(z, x *Int) *Int { return z.Root(x, 5) }) })
+	b.Run("IsSquare", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			int256Samples[i%numSamples].IsSquare()
+		}
+	})
+}
+
 func benchmark_And_Big(bench *testing.B) {
 	b1 := big.NewInt(0).SetBytes(hex2Bytes("0123456789abcdeffedcba9876543210f2f3f4f5f6f7f8f9fff3f4f5f6f7f8f9"))
 	b2 := big.NewInt(0).SetBytes(hex2Bytes("0123456789abcdefaaaaaa9876543210f2f3f4f5f6f7f8f9fff3f4f5f6f7f8f9"))
@@ -605,6 +628,360 @@ func Benchmark_Exp(bench *testing.B) {
 	bench.Run("small/uint256", benchmark_ExpSmall_Bit)
 }
 
+func BenchmarkExpMod(b *testing.B) {
+	benchmarkExpModUint256 := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var x Int
+			for i := 0; i < iter; i++ {
+				x.ExpMod(&factorsSamples[j], &int256Samples[j], &modSamples[j])
+			}
+		}
+	}
+	benchmarkExpModBig := func(b *testing.B, factorsSamples, modSamples *[numSamples]big.Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var x big.Int
+			for i := 0; i < iter; i++ {
+				x.Exp(&factorsSamples[j], &big256Samples[j], &modSamples[j])
+			}
+		}
+	}
+
+	b.Run("mod64/uint256", func(b *testing.B) { benchmarkExpModUint256(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod128/uint256", func(b *testing.B) { benchmarkExpModUint256(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod192/uint256", func(b *testing.B) { benchmarkExpModUint256(b, &int192SamplesLt, &int192Samples) })
+	b.Run("mod256/uint256", func(b *testing.B) { benchmarkExpModUint256(b, &int256SamplesLt, &int256Samples) })
+	b.Run("mod64/big", func(b *testing.B) { benchmarkExpModBig(b, &big64SamplesLt, &big64Samples) })
+	b.Run("mod128/big", func(b *testing.B) { benchmarkExpModBig(b, &big128SamplesLt, &big128Samples) })
+	b.Run("mod192/big", func(b *testing.B) { benchmarkExpModBig(b, &big192SamplesLt, &big192Samples) })
+	b.Run("mod256/big", func(b *testing.B) { benchmarkExpModBig(b, &big256SamplesLt, &big256Samples) })
+}
+
+func BenchmarkModInverse(b *testing.B) {
+	benchmarkModInverseUint256 := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var x Int
+			m := modSamples[j]
+			m[0] |= 1
+			for i := 0; i < iter; i++ {
+				x.ModInverse(&factorsSamples[j], &m)
+			}
+		}
+	}
+	benchmarkModInverseBig := func(b *testing.B, factorsSamples, modSamples *[numSamples]big.Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var x big.Int
+			m := new(big.Int).SetBit(&modSamples[j], 0, 1)
+			for i := 0; i < iter; i++ {
+				x.ModInverse(&factorsSamples[j], m)
+			}
+		}
+	}
+
+	b.Run("mod64/uint256", func(b *testing.B) { benchmarkModInverseUint256(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod128/uint256", func(b *testing.B) { benchmarkModInverseUint256(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod192/uint256", func(b *testing.B) { benchmarkModInverseUint256(b, &int192SamplesLt, &int192Samples) })
+	b.Run("mod256/uint256", func(b *testing.B) { benchmarkModInverseUint256(b, &int256SamplesLt, &int256Samples) })
+	b.Run("mod64/big", func(b *testing.B) { benchmarkModInverseBig(b, &big64SamplesLt, &big64Samples) })
+	b.Run("mod128/big", func(b *testing.B) { benchmarkModInverseBig(b, &big128SamplesLt, &big128Samples) })
+	b.Run("mod192/big", func(b *testing.B) { benchmarkModInverseBig(b, &big192SamplesLt, &big192Samples) })
+	b.Run("mod256/big", func(b *testing.B) { benchmarkModInverseBig(b, &big256SamplesLt, &big256Samples) })
+}
+
+func BenchmarkGCD(b *testing.B) {
+	benchmarkGCDUint256 := func(b *testing.B, xSamples, ySamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var z Int
+			for i := 0; i < iter; i++ {
+				z.GCD(&xSamples[j], &ySamples[j])
+			}
+		}
+	}
+	benchmarkGCDBig := func(b *testing.B, xSamples, ySamples *[numSamples]big.Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			var z big.Int
+			for i := 0; i < iter; i++ {
+				z.GCD(nil, nil, &xSamples[j], &ySamples[j])
+			}
+		}
+	}
+
+	b.Run("128/uint256", func(b *testing.B) { benchmarkGCDUint256(b, &int128SamplesLt, &int128Samples) })
+	b.Run("256/uint256", func(b *testing.B) { benchmarkGCDUint256(b, &int256SamplesLt, &int256Samples) })
+	b.Run("128/big", func(b *testing.B) { benchmarkGCDBig(b, &big128SamplesLt, &big128Samples) })
+	b.Run("256/big", func(b *testing.B) { benchmarkGCDBig(b, &big256SamplesLt, &big256Samples) })
+}
+
+func BenchmarkModSqrt(b *testing.B) {
+	benchmarkModSqrtUint256 := func(b *testing.B, hex string) {
+		p, _ := FromHex(hex)
+		var x Int
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			x.ModSqrt(&int256Samples[i%numSamples], p)
+		}
+	}
+	benchmarkModSqrtBig := func(b *testing.B, hex string) {
+		p, _ := new(big.Int).SetString(hex[2:], 16)
+		var x big.Int
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			x.ModSqrt(&big256Samples[i%numSamples], p)
+		}
+	}
+
+	const (
+		mod3mod4 = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f" // secp256k1 p
+		mod5mod8 = "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed" // 2**255-19
+		mod1mod8 = "0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001" // bn254 r
+	)
+	b.Run("3mod4/uint256", func(b *testing.B) { benchmarkModSqrtUint256(b, mod3mod4) })
+	b.Run("5mod8/uint256", func(b *testing.B) { benchmarkModSqrtUint256(b, mod5mod8) })
+	b.Run("1mod8/uint256", func(b *testing.B) { benchmarkModSqrtUint256(b, mod1mod8) })
+	b.Run("3mod4/big", func(b *testing.B) { benchmarkModSqrtBig(b, mod3mod4) })
+	b.Run("5mod8/big", func(b *testing.B) { benchmarkModSqrtBig(b, mod5mod8) })
+	b.Run("1mod8/big", func(b *testing.B) { benchmarkModSqrtBig(b, mod1mod8) })
+}
+
+func BenchmarkJacobi(b *testing.B) {
+	benchmarkJacobiUint256 := func(b *testing.B, xSamples, ySamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			y := ySamples[j]
+			y[0] |= 1
+			for i := 0; i < iter; i++ {
+				Jacobi(&xSamples[j], &y)
+			}
+		}
+	}
+	benchmarkJacobiBig := func(b *testing.B, xSamples, ySamples *[numSamples]big.Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+		b.ReportAllocs()
+		for j := 0; j < numSamples; j++ {
+			y := new(big.Int).SetBit(&ySamples[j], 0, 1)
+			for i := 0; i < iter; i++ {
+				big.Jacobi(&xSamples[j], y)
+			}
+		}
+	}
+
+	b.Run("single/uint256", func(b *testing.B) { benchmarkJacobiUint256(b, &int256SamplesLt, &int64Samples) })
+	b.Run("double/uint256", func(b *testing.B) { benchmarkJacobiUint256(b, &int256SamplesLt, &int128Samples) })
+	b.Run("triple/uint256", func(b *testing.B) { benchmarkJacobiUint256(b, &int256SamplesLt, &int192Samples) })
+	b.Run("full/uint256", func(b *testing.B) { benchmarkJacobiUint256(b, &int256SamplesLt, &int256Samples) })
+	b.Run("single/big", func(b *testing.B) { benchmarkJacobiBig(b, &big256SamplesLt, &big64Samples) })
+	b.Run("double/big", func(b *testing.B) { benchmarkJacobiBig(b, &big256SamplesLt, &big128Samples) })
+	b.Run("triple/big", func(b *testing.B) { benchmarkJacobiBig(b, &big256SamplesLt, &big192Samples) })
+	b.Run("full/big", func(b *testing.B) { benchmarkJacobiBig(b, &big256SamplesLt, &big256Samples) })
+}
+
+func BenchmarkProbablyPrime(b *testing.B) {
+	benchmarkUint256 := func(b *testing.B, x *Int, n int) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x.ProbablyPrime(n)
+		}
+	}
+	benchmarkBig := func(b *testing.B, x *Int, n int) {
+		xb := x.ToBig()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			xb.ProbablyPrime(n)
+		}
+	}
+
+	// secp256k1 p
+	p, _ := FromHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	b.Run("n0/uint256", func(b *testing.B) { benchmarkUint256(b, p, 0) })
+	b.Run("n20/uint256", func(b *testing.B) { benchmarkUint256(b, p, 20) })
+	b.Run("n0/big", func(b *testing.B) { benchmarkBig(b, p, 0) })
+	b.Run("n20/big", func(b *testing.B) { benchmarkBig(b, p, 20) })
+}
+
+func BenchmarkLog(b *testing.B) {
+	benchmarkLog := func(b *testing.B, f func(x *Int) int) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			f(&int256Samples[i%numSamples])
+		}
+	}
+	b.Run("Log2", func(b *testing.B) { benchmarkLog(b, (*Int).Log2) })
+	b.Run("CeilLog2", func(b *testing.B) { benchmarkLog(b, (*Int).CeilLog2) })
+	b.Run("Log10", func(b *testing.B) { benchmarkLog(b, (*Int).Log10) })
+	b.Run("DecLen", func(b *testing.B) { benchmarkLog(b, (*Int).DecLen) })
+	b.Run("LogBase3", func(b *testing.B) { benchmarkLog(b, func(x *Int) int { return x.LogBase(3) }) })
+}
+
+func BenchmarkSaturating(b *testing.B) {
+	benchmarkOp := func(b *testing.B, op func(z, x, y *Int) *Int) {
+		var z Int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			op(&z, &int256Samples[i%numSamples], &int256SamplesLt[i%numSamples])
+		}
+	}
+	b.Run("AddSat", func(b *testing.B) { benchmarkOp(b, (*Int).AddSat) })
+	b.Run("SubSat", func(b *testing.B) { benchmarkOp(b, (*Int).SubSat) })
+	b.Run("MulSat", func(b *testing.B) { benchmarkOp(b, (*Int).MulSat) })
+	b.Run("SAddSat", func(b *testing.B) { benchmarkOp(b, (*Int).SAddSat) })
+	b.Run("SSubSat", func(b *testing.B) { benchmarkOp(b, (*Int).SSubSat) })
+}
+
+func BenchmarkUint64Ops(b *testing.B) {
+	benchmarkOp := func(b *testing.B, op func(z, x *Int, y uint64)) {
+		var z Int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			op(&z, &int256Samples[i%numSamples], int64Samples[i%numSamples][0])
+		}
+	}
+	b.Run("MulUint64", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { z.MulUint64(x, y) })
+	})
+	b.Run("Mul", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { z.Mul(x, new(Int).SetUint64(y)) })
+	})
+	b.Run("DivUint64", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { z.DivUint64(x, y) })
+	})
+	b.Run("Div", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { z.Div(x, new(Int).SetUint64(y)) })
+	})
+	b.Run("ModUint64", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { x.ModUint64(y) })
+	})
+	b.Run("Mod", func(b *testing.B) {
+		benchmarkOp(b, func(z, x *Int, y uint64) { z.Mod(x, new(Int).SetUint64(y)) })
+	})
+}
+
+func BenchmarkDivRound(b *testing.B) {
+	benchmarkDivRound := func(b *testing.B, mode RoundingMode) {
+		var z Int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			z.DivRound(&int256Samples[i%numSamples], &int128Samples[i%numSamples], mode)
+		}
+	}
+	benchmarkMulDivRound := func(b *testing.B, mode RoundingMode) {
+		var z Int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			z.MulDivRoundOverflow(&int128Samples[i%numSamples], &int192Samples[i%numSamples], &int256Samples[i%numSamples], mode)
+		}
+	}
+	b.Run("Div/Down", func(b *testing.B) { benchmarkDivRound(b, RoundDown) })
+	b.Run("Div/HalfEven", func(b *testing.B) { benchmarkDivRound(b, RoundHalfEven) })
+	b.Run("MulDiv/Down", func(b *testing.B) { benchmarkMulDivRound(b, RoundDown) })
+	b.Run("MulDiv/Up", func(b *testing.B) { benchmarkMulDivRound(b, RoundUp) })
+	b.Run("MulDiv/HalfEven", func(b *testing.B) { benchmarkMulDivRound(b, RoundHalfEven) })
+}
+
+func BenchmarkFull(b *testing.B) {
+	b.Run("MulFull", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			MulFull(&int256Samples[i%numSamples], &int256SamplesLt[i%numSamples])
+		}
+	})
+	b.Run("MulAddFull", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			MulAddFull(&int256Samples[i%numSamples], &int256SamplesLt[i%numSamples], &int128Samples[i%numSamples])
+		}
+	})
+	b.Run("DivFull", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DivFull(&int192Samples[i%numSamples], &int256Samples[i%numSamples], &int256SamplesLt[i%numSamples])
+		}
+	})
+}
+
+func BenchmarkDivisor(b *testing.B) {
+	benchmarkDiv := func(b *testing.B, xSamples *[numSamples]Int, d *Int) {
+		var sink Int
+		for i := 0; i < b.N; i++ {
+			sink.Div(&xSamples[i%numSamples], d)
+		}
+	}
+	benchmarkDivisor := func(b *testing.B, xSamples *[numSamples]Int, d *Int) {
+		var sink Int
+		dv := NewDivisor(d)
+		for i := 0; i < b.N; i++ {
+			dv.Div(&sink, &xSamples[i%numSamples])
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		d    *Int
+	}{
+		{"mod64", &int64Samples[0]},
+		{"mod128", &int128Samples[0]},
+		{"mod192", &int192Samples[0]},
+		{"mod256", &int256SamplesLt[0]},
+	} {
+		b.Run(tc.name+"/Div", func(b *testing.B) { benchmarkDiv(b, &int256Samples, tc.d) })
+		b.Run(tc.name+"/Divisor", func(b *testing.B) { benchmarkDivisor(b, &int256Samples, tc.d) })
+	}
+}
+
+func BenchmarkModulus(b *testing.B) {
+	benchmarkMulMod := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+
+		for j := 0; j < numSamples; j++ {
+			x := factorsSamples[j]
+
+			for i := 0; i < iter; i++ {
+				x.MulMod(&x, &factorsSamples[j], &modSamples[j])
+			}
+		}
+	}
+	benchmarkModulus := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+
+		var md [numSamples]*Modulus
+		for i := 0; i < numSamples; i++ {
+			md[i] = NewModulus(&modSamples[i])
+		}
+
+		b.ResetTimer()
+
+		for j := 0; j < numSamples; j++ {
+			x := factorsSamples[j]
+
+			for i := 0; i < iter; i++ {
+				md[j].Mul(&x, &x, &factorsSamples[j])
+			}
+		}
+	}
+
+	b.Run("mod64/MulMod", func(b *testing.B) { benchmarkMulMod(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod64/Modulus", func(b *testing.B) { benchmarkModulus(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod128/MulMod", func(b *testing.B) { benchmarkMulMod(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod128/Modulus", func(b *testing.B) { benchmarkModulus(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod192/MulMod", func(b *testing.B) { benchmarkMulMod(b, &int192SamplesLt, &int192Samples) })
+	b.Run("mod192/Modulus", func(b *testing.B) { benchmarkModulus(b, &int192SamplesLt, &int192Samples) })
+	b.Run("mod256/MulMod", func(b *testing.B) { benchmarkMulMod(b, &int256SamplesLt, &int256Samples) })
+	b.Run("mod256/Modulus", func(b *testing.B) { benchmarkModulus(b, &int256SamplesLt, &int256Samples) })
+}
+
 func BenchmarkDiv(b *testing.B) {
 	benchmarkDivUint256 := func(b *testing.B, xSamples, modSamples *[numSamples]Int) {
 		var sink Int
@@ -724,6 +1101,29 @@ func BenchmarkMulMod(b *testing.B) {
 			}
 		}
 	}
+	benchmarkMulModUint256M := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
+		iter := (b.N + numSamples - 1) / numSamples
+
+		// Montgomery form needs odd moduli, which keeps the factors below them.
+		var (
+			mt      [numSamples]*Montgomery
+			factors [numSamples]Int
+		)
+		for i := 0; i < numSamples; i++ {
+			mt[i] = NewMontgomery(new(Int).SetBit(&modSamples[i], 0, 1))
+			mt[i].ToMont(&factors[i], &factorsSamples[i])
+		}
+
+		b.ResetTimer()
+
+		for j := 0; j < numSamples; j++ {
+			x := factors[j]
+
+			for i := 0; i < iter; i++ {
+				mt[j].MulMont(&x, &x, &factors[j])
+			}
+		}
+	}
 	benchmarkMulModUint256 := func(b *testing.B, factorsSamples, modSamples *[numSamples]Int) {
 		iter := (b.N + numSamples - 1) / numSamples
 
@@ -750,10 +1150,14 @@ func BenchmarkMulMod(b *testing.B) {
 
 	b.Run("small/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int32SamplesLt, &int32Samples) })
 	b.Run("mod64/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int64SamplesLt, &int64Samples) })
+	b.Run("mod64/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int64SamplesLt, &int64Samples) })
 	b.Run("mod128/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int128SamplesLt, &int128Samples) })
+	b.Run("mod128/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int128SamplesLt, &int128Samples) })
 	b.Run("mod192/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int192SamplesLt, &int192Samples) })
+	b.Run("mod192/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int192SamplesLt, &int192Samples) })
 	b.Run("mod256/uint256", func(b *testing.B) { benchmarkMulModUint256(b, &int256SamplesLt, &int256Samples) })
 	b.Run("mod256/uint256r", func(b *testing.B) { benchmarkMulModUint256R(b, &int256SamplesLt, &int256Samples) })
+	b.Run("mod256/uint256m", func(b *testing.B) { benchmarkMulModUint256M(b, &int256SamplesLt, &int256Samples) })
 	b.Run("small/big", func(b *testing.B) { benchmarkMulModBig(b, &big32SamplesLt, &big32Samples) })
 	b.Run("mod64/big", func(b *testing.B) { benchmarkMulModBig(b, &big64SamplesLt, &big64Samples) })
 	b.Run("mod128/big", func(b *testing.B) { benchmarkMulModBig(b, &big128SamplesLt, &big128Samples) })
@@ -814,6 +1218,40 @@ func Benchmark_EncodeHex(b *testing.B) {
 	b.Run("large/big", func(b *testing.B) { hexEncodeBig(b, &big256Samples) })
 }
 
+func Benchmark_EncodeDec(b *testing.B) {
+	decEncodeU256 := func(b *testing.B, samples *[numSamples]Int) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				samples[i].Dec()
+			}
+		}
+	}
+	decAppendU256 := func(b *testing.B, samples *[numSamples]Int) {
+		b.ReportAllocs()
+		var buf [78]byte
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				samples[i].AppendDec(buf[:0])
+			}
+		}
+	}
+	decEncodeBig := func(b *testing.B, samples *[numSamples]big.Int) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				samples[i].Text(10)
+			}
+		}
+	}
+	b.Run("small/uint256", func(b *testing.B) { decEncodeU256(b, &int64Samples) })
+	b.Run("small/uint256-append", func(b *testing.B) { decAppendU256(b, &int64Samples) })
+	b.Run("small/big", func(b *testing.B) { decEncodeBig(b, &big64Samples) })
+	b.Run("large/uint256", func(b *testing.B) { decEncodeU256(b, &int256Samples) })
+	b.Run("large/uint256-append", func(b *testing.B) { decAppendU256(b, &int256Samples) })
+	b.Run("large/big", func(b *testing.B) { decEncodeBig(b, &big256Samples) })
+}
+
 func Benchmark_DecodeHex(b *testing.B) {
 
 	var hexStrings []string
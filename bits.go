@@ -0,0 +1,156 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// Bit returns the value of the i'th bit of z, either 0 or 1, where i = 0 is
+// the LSB. Bits above 255 are 0.
+func (z *Int) Bit(i uint) uint {
+	if i > 255 {
+		return 0
+	}
+	return uint(z[i/64]>>(i%64)) & 1
+}
+
+// SetBit sets z to x, with x's i'th bit set to b (0 or 1), and returns z.
+// That is, if b is 1, SetBit sets z = x | (1 << i); if b is 0, SetBit sets
+// z = x &^ (1 << i). If b is not 0 or 1, SetBit will panic.
+// If i > 255, z is set to x (OBS: differs from the big.Int, which grows).
+func (z *Int) SetBit(x *Int, i uint, b uint) *Int {
+	switch b {
+	case 0:
+		return z.ClearBit(x, i)
+	case 1:
+		z.Set(x)
+		if i <= 255 {
+			z[i/64] |= 1 << (i % 64)
+		}
+		return z
+	}
+	panic("uint256: set bit is not 0 or 1")
+}
+
+// ClearBit sets z = x &^ (1 << i), and returns z.
+// If i > 255, z is set to x.
+func (z *Int) ClearBit(x *Int, i uint) *Int {
+	z.Set(x)
+	if i <= 255 {
+		z[i/64] &^= 1 << (i % 64)
+	}
+	return z
+}
+
+// FlipBit sets z = x ^ (1 << i), and returns z.
+// If i > 255, z is set to x.
+func (z *Int) FlipBit(x *Int, i uint) *Int {
+	z.Set(x)
+	if i <= 255 {
+		z[i/64] ^= 1 << (i % 64)
+	}
+	return z
+}
+
+// OnesCount returns the number of one bits ("population count") in z.
+func (z *Int) OnesCount() int {
+	return bits.OnesCount64(z[0]) + bits.OnesCount64(z[1]) +
+		bits.OnesCount64(z[2]) + bits.OnesCount64(z[3])
+}
+
+// LeadingZeros returns the number of leading zero bits in z; the result is
+// 256 for z == 0.
+func (z *Int) LeadingZeros() int {
+	return leadingZeros(z)
+}
+
+// TrailingZeros returns the number of trailing zero bits in z; the result is
+// 256 for z == 0 (OBS: differs from the big.Int, where
+// TrailingZeroBits returns 0 for 0).
+func (z *Int) TrailingZeros() int {
+	return int(trailingZeros(z))
+}
+
+// RotateLeft sets z to the value of x rotated left by (k mod 256) bits,
+// and returns z.
+func (z *Int) RotateLeft(x *Int, k uint) *Int {
+	var (
+		t = *x
+		w = (k / 64) % 4
+		s = k % 64
+	)
+	for i := uint(0); i < 4; i++ {
+		z[i] = t[(i-w)%4]<<s | t[(i-w-1)%4]>>(64-s)
+	}
+	return z
+}
+
+// RotateRight sets z to the value of x rotated right by (k mod 256) bits,
+// and returns z.
+func (z *Int) RotateRight(x *Int, k uint) *Int {
+	return z.RotateLeft(x, 256-k%256)
+}
+
+// ReverseBits sets z to the value of x with its bits in reversed order,
+// and returns z.
+func (z *Int) ReverseBits(x *Int) *Int {
+	z[0], z[1], z[2], z[3] = bits.Reverse64(x[3]), bits.Reverse64(x[2]), bits.Reverse64(x[1]), bits.Reverse64(x[0])
+	return z
+}
+
+// ReverseBytes sets z to the value of x with its bytes in reversed order,
+// and returns z.
+func (z *Int) ReverseBytes(x *Int) *Int {
+	z[0], z[1], z[2], z[3] = bits.ReverseBytes64(x[3]), bits.ReverseBytes64(x[2]), bits.ReverseBytes64(x[1]), bits.ReverseBytes64(x[0])
+	return z
+}
+
+// Mask sets z to 2^width - 1, the value with the low width bits set, and
+// returns z. If width > 255, all bits are set.
+func (z *Int) Mask(width uint) *Int {
+	for i := range z {
+		switch {
+		case width >= 64:
+			z[i] = ^uint64(0)
+			width -= 64
+		default:
+			z[i] = 1<<width - 1
+			width = 0
+		}
+	}
+	return z
+}
+
+// ExtractBits sets z to the width bits of x starting at bit offset, that is
+// (x >> offset) & (2^width - 1), and returns z.
+func (z *Int) ExtractBits(x *Int, offset, width uint) *Int {
+	var mask Int
+	mask.Mask(width)
+	return z.Rsh(x, offset).And(z, &mask)
+}
+
+// InsertBits sets z to dst, with the width bits starting at bit offset
+// replaced by the low width bits of val, and returns z. Bits that would be
+// placed above bit 255 are dropped.
+func (z *Int) InsertBits(dst, val *Int, offset, width uint) *Int {
+	var mask, v Int
+	mask.Mask(width).Lsh(&mask, offset)
+	v.Lsh(val, offset).And(&v, &mask)
+	return z.And(dst, mask.Not(&mask)).Or(z, &v)
+}
+
+// extendSignBits sets z to x sign-extended from its low width bits, that is,
+// bit width-1 of x is copied to all the bits above it, and returns z.
+// If width == 0 or width > 255, z is set to x.
+func (z *Int) extendSignBits(x *Int, width uint) *Int {
+	if width == 0 || width > 255 {
+		return z.Set(x)
+	}
+	var mask Int
+	mask.Mask(width)
+	if x.isBitSet(width - 1) {
+		return z.Or(x, mask.Not(&mask))
+	}
+	return z.And(x, &mask)
+}
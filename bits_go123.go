@@ -0,0 +1,27 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build go1.23
+
+package uint256
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Ones returns an iterator over the positions of the one bits in z, in
+// increasing order. The value of z is captured when Ones is called.
+func (z *Int) Ones() iter.Seq[int] {
+	x := *z
+	return func(yield func(int) bool) {
+		for i, w := range x {
+			for ; w != 0; w &= w - 1 {
+				if !yield(i*64 + bits.TrailingZeros64(w)) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+//go:build go1.23
+
+package uint256
+
+import "testing"
+
+func TestOnes(t *testing.T) {
+	values := []*Int{
+		new(Int),
+		NewInt(1),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+	}
+	for i := 0; i < 1000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		values = append(values, x)
+	}
+	for _, x := range values {
+		var (
+			want []int
+			have []int
+		)
+		for i := uint(0); i < 256; i++ {
+			if x.Bit(i) == 1 {
+				want = append(want, int(i))
+			}
+		}
+		for i := range x.Ones() {
+			have = append(have, i)
+		}
+		if len(have) != len(want) {
+			t.Fatalf("Ones(%#x): have %v want %v", x, have, want)
+		}
+		for i := range have {
+			if have[i] != want[i] {
+				t.Fatalf("Ones(%#x): have %v want %v", x, have, want)
+			}
+		}
+		// Stopping early.
+		n := 0
+		for range x.Ones() {
+			if n++; n == 3 {
+				break
+			}
+		}
+		if min(len(want), 3) != n {
+			t.Fatalf("Ones(%#x): stopped after %d values, want %d", x, n, min(len(want), 3))
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testBits checks the bit functions on x against big.Int, and against each
+// other.
+func testBits(t *testing.T, x *Int) {
+	t.Helper()
+	b := x.ToBig()
+	for i := uint(0); i < 260; i++ {
+		if have, want := x.Bit(i), b.Bit(int(i)); have != want {
+			t.Fatalf("Bit(%#x, %d): have %d want %d", x, i, have, want)
+		}
+		for _, v := range []uint{0, 1} {
+			want := new(big.Int).SetBit(b, int(i), v)
+			if i > 255 {
+				want.Set(b)
+			}
+			if z := new(Int).SetBit(x, i, v); !checkEq(want, z) {
+				t.Fatalf("SetBit(%#x, %d, %d): have %#x want %#x", x, i, v, z, want)
+			}
+		}
+		if z, want := new(Int).ClearBit(x, i), new(Int).SetBit(x, i, 0); !z.Eq(want) {
+			t.Fatalf("ClearBit(%#x, %d): have %#x want %#x", x, i, z, want)
+		}
+		if z, want := new(Int).FlipBit(x, i), new(Int).SetBit(x, i, 1-x.Bit(i)); !z.Eq(want) {
+			t.Fatalf("FlipBit(%#x, %d): have %#x want %#x", x, i, z, want)
+		}
+	}
+	ones := 0
+	for i := 0; i < b.BitLen(); i++ {
+		ones += int(b.Bit(i))
+	}
+	if have := x.OnesCount(); have != ones {
+		t.Fatalf("OnesCount(%#x): have %d want %d", x, have, ones)
+	}
+	if have, want := x.LeadingZeros(), 256-b.BitLen(); have != want {
+		t.Fatalf("LeadingZeros(%#x): have %d want %d", x, have, want)
+	}
+	wantTz := int(b.TrailingZeroBits())
+	if x.IsZero() {
+		wantTz = 256
+	}
+	if have := x.TrailingZeros(); have != wantTz {
+		t.Fatalf("TrailingZeros(%#x): have %d want %d", x, have, wantTz)
+	}
+	for _, k := range []uint{0, 1, 7, 63, 64, 65, 127, 128, 200, 255, 256, 257, 1000} {
+		r := k % 256
+		want := new(big.Int).Lsh(b, r)
+		want.Or(want, new(big.Int).Rsh(b, 256-r))
+		want.Mod(want, bigtt256)
+		if z := new(Int).RotateLeft(x, k); !checkEq(want, z) {
+			t.Fatalf("RotateLeft(%#x, %d): have %#x want %#x", x, k, z, want)
+		}
+		if z := new(Int).RotateRight(x, 256-r); !checkEq(want, z) {
+			t.Fatalf("RotateRight(%#x, %d): have %#x want %#x", x, 256-r, z, want)
+		}
+		if z := x.Clone(); !z.RotateLeft(z, k).RotateRight(z, k).Eq(x) {
+			t.Fatalf("RotateRight(RotateLeft(%#x, %d)): have %#x", x, k, z)
+		}
+	}
+	var wantRev Int
+	for i := uint(0); i < 256; i++ {
+		wantRev.SetBit(&wantRev, 255-i, x.Bit(i))
+	}
+	if z := x.Clone(); !z.ReverseBits(z).Eq(&wantRev) {
+		t.Fatalf("ReverseBits(%#x): have %#x want %#x", x, z, &wantRev)
+	}
+	var (
+		bytes    = x.Bytes32()
+		revBytes [32]byte
+	)
+	for i := range bytes {
+		revBytes[i] = bytes[31-i]
+	}
+	if z := x.Clone(); !z.ReverseBytes(z).Eq(new(Int).SetBytes32(revBytes[:])) {
+		t.Fatalf("ReverseBytes(%#x): have %#x want %x", x, z, revBytes)
+	}
+}
+
+func TestBits(t *testing.T) {
+	values := []*Int{
+		new(Int),
+		NewInt(1),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+		new(Int).Lsh(NewInt(1), 64),
+		NewInt(1<<64 - 1),
+	}
+	for _, x := range values {
+		testBits(t, x)
+	}
+	for i := 0; i < 1000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testBits(t, x)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SetBit(x, 0, 2): expected panic")
+		}
+	}()
+	new(Int).SetBit(NewInt(1), 0, 2)
+}
+
+func TestBitFields(t *testing.T) {
+	bigMask := func(width uint) *big.Int {
+		if width > 256 {
+			width = 256
+		}
+		m := new(big.Int).Lsh(big.NewInt(1), width)
+		return m.Sub(m, big.NewInt(1))
+	}
+	widths := []uint{0, 1, 7, 8, 63, 64, 65, 128, 160, 200, 255, 256, 300}
+	for _, w := range widths {
+		if z := new(Int).Mask(w); !checkEq(bigMask(w), z) {
+			t.Fatalf("Mask(%d): have %#x want %#x", w, z, bigMask(w))
+		}
+	}
+	for i := 0; i < 200; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, val, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		bx, bval := x.ToBig(), val.ToBig()
+		for _, offset := range []uint{0, 1, 8, 63, 64, 100, 160, 255, 256, 300} {
+			for _, w := range widths {
+				want := new(big.Int).Rsh(bx, offset)
+				want.And(want, bigMask(w))
+				if z := x.Clone(); !checkEq(want, z.ExtractBits(z, offset, w)) {
+					t.Fatalf("ExtractBits(%#x, %d, %d): have %#x want %#x", x, offset, w, z, want)
+				}
+				// dst &^ (mask << offset) | (val & mask) << offset
+				m := new(big.Int).Lsh(bigMask(w), offset)
+				want = new(big.Int).AndNot(bx, m)
+				want.Or(want, new(big.Int).And(new(big.Int).Lsh(bval, offset), m))
+				want.And(want, bigMask(256))
+				if z := x.Clone(); !checkEq(want, z.InsertBits(z, val, offset, w)) {
+					t.Fatalf("InsertBits(%#x, %#x, %d, %d): have %#x want %#x", x, val, offset, w, z, want)
+				}
+				if z := val.Clone(); !checkEq(want, z.InsertBits(x, z, offset, w)) {
+					t.Fatalf("InsertBits(%#x, %#x, %d, %d) with z == val: have %#x want %#x", x, val, offset, w, z, want)
+				}
+			}
+		}
+		for _, w := range widths {
+			// Sign extension from w bits, compared to the byte-granular
+			// ExtendSign where they overlap.
+			want := new(big.Int).And(bx, bigMask(w))
+			if w > 0 && w < 256 && want.Bit(int(w-1)) == 1 {
+				want.Or(want, new(big.Int).AndNot(bigMask(256), bigMask(w)))
+			} else if w == 0 || w >= 256 {
+				want.Set(bx)
+			}
+			if z := x.Clone(); !checkEq(want, z.extendSignBits(z, w)) {
+				t.Fatalf("extendSignBits(%#x, %d): have %#x want %#x", x, w, z, want)
+			}
+			if w%8 == 0 && w > 0 && w <= 256 {
+				if z, want := new(Int).extendSignBits(x, w), new(Int).ExtendSign(x, NewInt(uint64(w/8-1))); !z.Eq(want) {
+					t.Fatalf("extendSignBits(%#x, %d): have %#x, ExtendSign gives %#x", x, w, z, want)
+				}
+			}
+		}
+	}
+}
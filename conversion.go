@@ -16,6 +16,7 @@ import (
 	"math/big"
 	"math/bits"
 	"strings"
+	"sync"
 )
 
 const (
@@ -106,6 +107,184 @@ func (z *Int) fromHex(hex string) error {
 	return nil
 }
 
+// SetString sets z to the value of s, interpreted in the given base, and
+// returns z and a boolean indicating success. The semantics follow those of
+// (*big.Int).SetString:
+//
+// The base argument must be 0 or a value between 2 and 62. For base 0, the
+// number prefix determines the actual base: a prefix of "0b" or "0B" selects
+// base 2, "0", "0o" or "0O" selects base 8, and "0x" or "0X" selects base 16.
+// Otherwise, the selected base is 10 and no prefix is accepted. For base 0,
+// an underscore character "_" may appear between a base prefix and an
+// adjacent digit, and between successive digits.
+//
+// For bases <= 36, lower and upper case letters are considered the same:
+// the letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit
+// values 36 to 61.
+//
+// OBS! Unlike big.Int, a leading "-" is not accepted (not even for zero),
+// and values larger than 256 bits are rejected.
+// If the operation fails, the value of z is unmodified and nil is returned.
+func (z *Int) SetString(s string, base int) (*Int, bool) {
+	if base != 0 && (base < 2 || base > maxBase) {
+		panic(fmt.Sprintf("invalid number base %d", base))
+	}
+	if len(s) > 0 && s[0] == '+' {
+		s = s[1:]
+	}
+	var (
+		b        = base
+		i        int        // index of the next character
+		count    int        // number of digits seen
+		prev     byte = '.' // '_', '0' (a digit or base prefix), or '.' (anything else)
+		invalSep bool
+	)
+	// Determine actual base.
+	if base == 0 {
+		// Actual base is 10 unless there's a base prefix.
+		b = 10
+		if len(s) > 0 && s[0] == '0' {
+			prev, count, i = '0', 1, 1
+			if len(s) > 1 {
+				// possibly one of 0b, 0B, 0o, 0O, 0x, 0X
+				switch s[1] {
+				case 'b', 'B':
+					b, i = 2, 2
+				case 'o', 'O':
+					b, i = 8, 2
+				case 'x', 'X':
+					b, i = 16, 2
+				default:
+					b = 8
+				}
+				count = 0 // prefix is not counted
+			}
+		}
+	}
+	// Collect digits in chunks of one word, and add each chunk to the result
+	// once the next digit would not fit.
+	var (
+		x     Int
+		b1    = uint64(b)
+		chunk uint64
+		mult  uint64 = 1 // b1 ** (number of digits in chunk)
+	)
+	for ; i < len(s); i++ {
+		ch := s[i]
+		if ch == '_' && base == 0 {
+			if prev != '0' {
+				invalSep = true
+			}
+			prev = '_'
+			continue
+		}
+		var d uint64
+		switch {
+		case '0' <= ch && ch <= '9':
+			d = uint64(ch - '0')
+		case 'a' <= ch && ch <= 'z':
+			d = uint64(ch - 'a' + 10)
+		case 'A' <= ch && ch <= 'Z':
+			if b <= 36 {
+				d = uint64(ch - 'A' + 10)
+			} else {
+				d = uint64(ch - 'A' + 36)
+			}
+		default:
+			d = maxBase + 1
+		}
+		if d >= b1 {
+			return nil, false
+		}
+		prev = '0'
+		count++
+		if hi, _ := bits.Mul64(mult, b1); hi != 0 {
+			if x.mulAddUint64(mult, chunk) != 0 {
+				return nil, false
+			}
+			chunk, mult = 0, 1
+		}
+		chunk = chunk*b1 + d
+		mult *= b1
+	}
+	if count == 0 || invalSep || prev == '_' {
+		return nil, false
+	}
+	if x.mulAddUint64(mult, chunk) != 0 {
+		return nil, false
+	}
+	return z.Set(&x), true
+}
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 62, inclusive. The result uses the
+// lower-case letters 'a' to 'z' for digit values 10 to 35, and
+// the upper-case letters 'A' to 'Z' for digit values 36 to 61.
+// No prefix (such as "0x") is added to the string.
+func (z *Int) Text(base int) string {
+	var buf [256]byte
+	return string(z.AppendText(buf[:0], base))
+}
+
+// AppendText appends the string representation of z, as generated by
+// z.Text(base), to dst and returns the extended buffer.
+func (z *Int) AppendText(dst []byte, base int) []byte {
+	switch base {
+	case 2:
+		return z.appendPow2(dst, 1)
+	case 4:
+		return z.appendPow2(dst, 2)
+	case 8:
+		return z.appendPow2(dst, 3)
+	case 10:
+		return z.AppendDec(dst)
+	case 16:
+		return z.appendPow2(dst, 4)
+	case 32:
+		return z.appendPow2(dst, 5)
+	}
+	if base < 2 || base > maxBase {
+		panic(fmt.Sprintf("invalid number base %d", base))
+	}
+	// Find the largest power of the base which fits in a word, and
+	// split the value into chunks of that many digits.
+	b := uint64(base)
+	bb, n := b, 1
+	for {
+		hi, lo := bits.Mul64(bb, b)
+		if hi != 0 {
+			break
+		}
+		bb, n = lo, n+1
+	}
+	var (
+		buf     [256]byte
+		pos     = len(buf)
+		x       = *z
+		divisor = Int{bb}
+	)
+	for !x.IsUint64() {
+		var quot Int
+		rem := udivrem(quot[:], x[:], &divisor)
+		r := rem[0]
+		for j := 0; j < n; j++ {
+			pos--
+			buf[pos] = digitChars[r%b]
+			r /= b
+		}
+		x = quot
+	}
+	for r := x[0]; ; {
+		pos--
+		buf[pos] = digitChars[r%b]
+		if r /= b; r == 0 {
+			break
+		}
+	}
+	return append(dst, buf[pos:]...)
+}
+
 // FromHex is a convenience-constructor to create an Int from
 // a hexadecimal string. The string is required to be '0x'-prefixed
 // Numbers larger than 256 bits are not accepted.
@@ -175,8 +354,173 @@ func (z *Int) SetFromBig(b *big.Int) bool {
 // specification of minimum digits precision, output field
 // width, space or zero padding, and '-' for left or right
 // justification.
+// The output is identical to that of (*big.Int).Format.
 func (z *Int) Format(s fmt.State, ch rune) {
-	z.ToBig().Format(s, ch)
+	formatInt(s, ch, z, false)
+}
+
+// formatInt implements Format for the magnitude abs, printed as a negative
+// number if neg is set. A nil abs is printed as "<nil>".
+func formatInt(s fmt.State, ch rune, abs *Int, neg bool) {
+	// determine base
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o', 'O':
+		base = 8
+	case 'd', 's', 'v':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	default:
+		// unknown format
+		switch {
+		case abs == nil:
+			fmt.Fprintf(s, "%%!%c(big.Int=<nil>)", ch)
+		case neg:
+			fmt.Fprintf(s, "%%!%c(big.Int=-%s)", ch, abs.Dec())
+		default:
+			fmt.Fprintf(s, "%%!%c(big.Int=%s)", ch, abs.Dec())
+		}
+		return
+	}
+
+	if abs == nil {
+		writeRepeated(s, "<nil>", 1)
+		return
+	}
+
+	// determine sign character
+	sign := ""
+	switch {
+	case neg:
+		sign = "-"
+	case s.Flag('+'): // supersedes ' ' when both specified
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	// determine prefix characters for indicating output base
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b': // binary
+			prefix = "0b"
+		case 'o': // octal
+			prefix = "0"
+		case 'x': // hexadecimal
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	// The digits are written via the fmt.State interface, which would make a
+	// stack-allocated buffer escape to the heap. Use a pooled one instead.
+	buf := formatBuffers.Get().(*[256]byte)
+	defer formatBuffers.Put(buf)
+	digits := abs.AppendText(buf[:0], base)
+	if ch == 'X' {
+		for i, d := range digits {
+			if 'a' <= d && d <= 'z' {
+				digits[i] = 'A' + (d - 'a')
+			}
+		}
+	}
+
+	// number of characters for the three classes of number padding
+	var left int  // space characters to left of digits for right justification ("%8d")
+	var zeros int // zero characters as left-most digits ("%.8d")
+	var right int // space characters to right of digits for left justification ("%-8d")
+
+	// determine number padding from precision: the least number of digits to output
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits) // count of zero padding
+		case len(digits) == 1 && digits[0] == '0' && precision == 0:
+			return // print nothing if zero value (z == 0) and zero precision ("." or ".0")
+		}
+	}
+
+	// determine field pad from width: the least number of characters to output
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width { // pad as specified
+		switch d := width - length; {
+		case s.Flag('-'):
+			// pad on the right with spaces; supersedes '0' when both specified
+			right = d
+		case s.Flag('0') && !precisionSet:
+			// pad with zeros unless precision also specified
+			zeros = d
+		default:
+			// pad on the left with spaces
+			left = d
+		}
+	}
+
+	// print number as [left pad][sign][prefix][zero pad][digits][right pad]
+	writeRepeated(s, " ", left)
+	writeRepeated(s, sign, 1)
+	writeRepeated(s, prefix, 1)
+	writeRepeated(s, "0", zeros)
+	s.Write(digits)
+	writeRepeated(s, " ", right)
+}
+
+// formatBuffers holds scratch buffers for Format, large enough for the
+// binary representation of any Int.
+var formatBuffers = sync.Pool{
+	New: func() interface{} { return new([256]byte) },
+}
+
+// writeRepeated writes text count times to s.
+func writeRepeated(s fmt.State, text string, count int) {
+	if len(text) == 0 || count <= 0 {
+		return
+	}
+	// Most implementations, including fmt's own, can take a string without
+	// the []byte conversion (which allocates).
+	if sw, ok := s.(io.StringWriter); ok {
+		for ; count > 0; count-- {
+			sw.WriteString(text)
+		}
+		return
+	}
+	b := []byte(text)
+	for ; count > 0; count-- {
+		s.Write(b)
+	}
+}
+
+// appendPow2 appends the digits of z in base 2^shift to dst, and returns
+// the extended buffer. The shift must be in the range [1, 5].
+func (z *Int) appendPow2(dst []byte, shift uint) []byte {
+	var (
+		mask = uint64(1)<<shift - 1
+		n    = (uint(z.BitLen()) + shift - 1) / shift // number of digits
+	)
+	if n == 0 {
+		return append(dst, '0')
+	}
+	// Emit the digits most significant first. A digit may straddle two
+	// words when 64 is not a multiple of the shift.
+	for i := int(n) - 1; i >= 0; i-- {
+		pos := uint(i) * shift
+		w, off := pos/64, pos%64
+		d := z[w] >> off
+		if off+shift > 64 && w < 3 {
+			d |= z[w+1] << (64 - off)
+		}
+		dst = append(dst, digitChars[d&mask])
+	}
+	return dst
 }
 
 // SetBytes8 is identical to SetBytes(in[:8]), but panics is input is too short
@@ -492,6 +836,93 @@ func (z *Int) EncodeRLP(w io.Writer) error {
 	return err
 }
 
+var (
+	// ErrRLPExpectedString is returned when decoding an RLP list, instead
+	// of the string or single byte encoding an integer.
+	ErrRLPExpectedString = errors.New("rlp: expected String or Byte")
+	// ErrRLPCanonInt is returned when the RLP payload of an integer has
+	// leading zero bytes.
+	ErrRLPCanonInt = errors.New("rlp: non-canonical integer format")
+	// ErrRLPCanonSize is returned when a single byte below 0x80 is wrapped
+	// in a string header, instead of being encoded as itself.
+	ErrRLPCanonSize = errors.New("rlp: non-canonical size information")
+	// ErrRLPValueTooLarge is returned when the size in an RLP header exceeds
+	// the remaining input.
+	ErrRLPValueTooLarge = errors.New("rlp: value size exceeds available input length")
+	// ErrRLPUint256Range is returned when the RLP payload of an integer is
+	// longer than 32 bytes.
+	ErrRLPUint256Range = errors.New("rlp: uint256 overflow")
+)
+
+// RLPStream is the subset of go-ethereum's *rlp.Stream needed by DecodeRLP.
+type RLPStream interface {
+	// Raw reads a raw encoded value, including RLP type information.
+	Raw() ([]byte, error)
+}
+
+// DecodeRLP reads the next value from s and decodes it into z.
+// The value must be the canonical RLP encoding of an integer of at
+// most 256 bits, as produced by EncodeRLP.
+//
+// DecodeRLP does not implement go-ethereum's rlp.Decoder, whose method
+// takes a *rlp.Stream, so the rlp package never calls it, and decodes the
+// Int with its own rules instead. A *rlp.Stream satisfies RLPStream, so a
+// wrapper type can enforce these checks with:
+//
+//	type Uint256 struct{ uint256.Int }
+//
+//	func (u *Uint256) DecodeRLP(s *rlp.Stream) error {
+//		return u.Int.DecodeRLP(s)
+//	}
+func (z *Int) DecodeRLP(s RLPStream) error {
+	raw, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	_, err = z.DecodeRLPBytes(raw)
+	return err
+}
+
+// DecodeRLPBytes decodes the RLP-encoded integer at the start of b into z,
+// and returns the remaining bytes following it.
+// Non-canonical encodings are rejected:
+//   - leading zero bytes in the payload (including the single byte 0x00),
+//   - single bytes below 0x80 wrapped in a string header,
+//   - payloads larger than 32 bytes,
+//   - list headers.
+//
+// Empty input gives io.ErrUnexpectedEOF. On error, z is left unmodified.
+func (z *Int) DecodeRLPBytes(b []byte) (rest []byte, err error) {
+	if len(b) == 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	switch prefix := b[0]; {
+	case prefix == 0x00:
+		return nil, ErrRLPCanonInt
+	case prefix < 0x80:
+		z.SetUint64(uint64(prefix))
+		return b[1:], nil
+	case prefix <= 0xa0:
+		size := int(prefix - 0x80)
+		if len(b) < 1+size {
+			return nil, ErrRLPValueTooLarge
+		}
+		payload := b[1 : 1+size]
+		if size == 1 && payload[0] < 0x80 {
+			return nil, ErrRLPCanonSize
+		}
+		if size > 0 && payload[0] == 0 {
+			return nil, ErrRLPCanonInt
+		}
+		z.SetBytes(payload)
+		return b[1+size:], nil
+	case prefix < 0xc0:
+		return nil, ErrRLPUint256Range
+	default:
+		return nil, ErrRLPExpectedString
+	}
+}
+
 // MarshalText implements encoding.TextMarshaler
 func (z *Int) MarshalText() ([]byte, error) {
 	return []byte(z.Hex()), nil
@@ -516,9 +947,11 @@ func (z *Int) String() string {
 }
 
 const (
-	hextable  = "0123456789abcdef"
-	bintable  = "\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x00\x01\x02\x03\x04\x05\x06\a\b\t\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff"
-	badNibble = 0xff
+	maxBase    = 62 // largest base accepted by SetString and Text
+	digitChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	hextable   = "0123456789abcdef"
+	bintable   = "\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x00\x01\x02\x03\x04\x05\x06\a\b\t\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\n\v\f\r\x0e\x0f\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff"
+	badNibble  = 0xff
 )
 
 // Hex encodes z in 0x-prefixed hexadecimal form.
@@ -601,7 +1034,7 @@ func (dst *Int) Scan(src interface{}) error {
 // In MariaDB/MySQL, this will work with the Numeric/Decimal types up to 65 digits, however any more and you should use either VarChar or Char(79)
 // In SqLite, use TEXT
 func (src *Int) Value() (driver.Value, error) {
-	return src.ToBig().String(), nil
+	return src.Dec(), nil
 }
 
 var (
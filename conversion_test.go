@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -236,6 +238,225 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+// TestFormatVsBig compares Format against (*big.Int).Format, for all
+// supported verbs and all combinations of flags.
+func TestFormatVsBig(t *testing.T) {
+	values := []*Int{
+		new(Int),
+		NewInt(1),
+		NewInt(7),
+		NewInt(0xdeadbeef),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+		new(Int).Lsh(NewInt(1), 64),
+	}
+	for i := 0; i < 8; i++ {
+		_, f, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, f)
+	}
+	var (
+		verbs       = "boOdxXsvq"
+		flags       = "#+ -0"
+		widths      = []string{"", "0", "1", "5", "70", "300"}
+		precisions  = []string{"", ".", ".0", ".1", ".5", ".70", ".300"}
+		flagCombos  []string
+		checkFormat = func(format string) {
+			for _, v := range values {
+				want := fmt.Sprintf(format, v.ToBig())
+				have := fmt.Sprintf(format, v)
+				if have != want {
+					t.Fatalf("format %q of %#x:\nhave %q\nwant %q", format, v.ToBig(), have, want)
+				}
+			}
+		}
+	)
+	for mask := 0; mask < 1<<len(flags); mask++ {
+		var combo []byte
+		for j := 0; j < len(flags); j++ {
+			if mask&(1<<j) != 0 {
+				combo = append(combo, flags[j])
+			}
+		}
+		flagCombos = append(flagCombos, string(combo))
+	}
+	for _, verb := range verbs {
+		for _, fl := range flagCombos {
+			for _, w := range widths {
+				for _, p := range precisions {
+					checkFormat("%" + fl + w + p + string(verb))
+				}
+			}
+		}
+	}
+	// nil
+	var z *Int
+	for _, format := range []string{"%d", "%x", "%10v", "%q"} {
+		if have, want := fmt.Sprintf(format, z), fmt.Sprintf(format, (*big.Int)(nil)); have != want {
+			t.Errorf("format %q of nil: have %q want %q", format, have, want)
+		}
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	formatU256 := func(b *testing.B, format string, samples *[numSamples]Int) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				fmt.Fprintf(io.Discard, format, &samples[i])
+			}
+		}
+	}
+	formatBig := func(b *testing.B, format string, samples *[numSamples]big.Int) {
+		b.ReportAllocs()
+		for j := 0; j < b.N; j += numSamples {
+			for i := 0; i < numSamples; i++ {
+				fmt.Fprintf(io.Discard, format, &samples[i])
+			}
+		}
+	}
+	for _, format := range []string{"%d", "%x", "%#080x"} {
+		b.Run(format+"/uint256", func(b *testing.B) { formatU256(b, format, &int256Samples) })
+		b.Run(format+"/big", func(b *testing.B) { formatBig(b, format, &big256Samples) })
+	}
+}
+
+func TestText(t *testing.T) {
+	values := []*Int{
+		new(Int),
+		NewInt(1),
+		NewInt(61),
+		NewInt(62),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 64),
+		new(Int).Lsh(NewInt(1), 255),
+	}
+	for i := 0; i < 16; i++ {
+		_, f, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, f)
+	}
+	for base := 2; base <= 62; base++ {
+		for _, v := range values {
+			want := v.ToBig().Text(base)
+			if have := v.Text(base); have != want {
+				t.Fatalf("base %d of %#x:\nhave %q\nwant %q", base, v.ToBig(), have, want)
+			}
+			if have := string(v.AppendText([]byte("abc"), base)); have != "abc"+want {
+				t.Fatalf("append base %d of %#x:\nhave %q\nwant %q", base, v.ToBig(), have, "abc"+want)
+			}
+			z, ok := new(Int).SetString(want, base)
+			if !ok || !z.Eq(v) {
+				t.Fatalf("SetString(%q, %d): have %v, %v want %v", want, base, z, ok, v)
+			}
+		}
+	}
+}
+
+var setStringCases = []struct {
+	s    string
+	base int
+}{
+	{"", 0}, {"0", 0}, {"-0", 0}, {"+0", 0}, {"+", 0}, {"-1", 10}, {"00", 0},
+	{"1", 0}, {"01", 0}, {"08", 0}, {"0_0", 0}, {"0_", 0}, {"0_8", 0}, {"_1", 0},
+	{"1_", 0}, {"1__0", 0}, {"1_0", 0}, {"1_0", 10}, {"0x", 0}, {"0x_1", 0},
+	{"0X1f", 0}, {"0o_7", 0}, {"0O8", 0}, {"0b", 0}, {"0B101", 0}, {"0b2", 0},
+	{"+0x1", 0}, {"0x10", 16}, {"10", 16}, {"fF", 16}, {"z", 36}, {"Z", 36},
+	{"Z", 62}, {"z", 62}, {"zz", 35}, {" 1", 0}, {"1 ", 0}, {"1.0", 10},
+	{"115792089237316195423570985008687907853269984665640564039457584007913129639935", 0},
+	{"115792089237316195423570985008687907853269984665640564039457584007913129639936", 0},
+	{"1157920892373161954235709850086879078532699846656405640394575840079131296399350", 0},
+	{"0x" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0},
+	{"0x1" + "0000000000000000000000000000000000000000000000000000000000000000", 0},
+	{"0x000000000000000000000000000000000000000000000000000000000000000000000001", 0},
+	{"0b" + strings.Repeat("1", 256), 0},
+	{"0b1" + strings.Repeat("0", 256), 0},
+	{strings.Repeat("1", 100), 2},
+	{strings.Repeat("z", 49), 62},
+	{strings.Repeat("Z", 44), 62},
+}
+
+// testSetString checks SetString against (*big.Int).SetString, which is
+// expected to agree on all non-negative inputs up to 256 bits.
+func testSetString(t *testing.T, s string, base int) {
+	t.Helper()
+	want, wantOk := new(big.Int).SetString(s, base)
+	if wantOk && (strings.HasPrefix(s, "-") || want.BitLen() > 256) {
+		wantOk = false
+	}
+	z := NewInt(1337)
+	have, haveOk := z.SetString(s, base)
+	if haveOk != wantOk {
+		t.Fatalf("SetString(%q, %d): have ok=%v want ok=%v", s, base, haveOk, wantOk)
+	}
+	if !haveOk {
+		if have != nil || z.Uint64() != 1337 {
+			t.Fatalf("SetString(%q, %d): failed call modified the receiver", s, base)
+		}
+		return
+	}
+	if have != z {
+		t.Fatalf("SetString(%q, %d): did not return receiver", s, base)
+	}
+	if have.ToBig().Cmp(want) != 0 {
+		t.Fatalf("SetString(%q, %d): have %v want %v", s, base, have.ToBig(), want)
+	}
+}
+
+func TestSetString(t *testing.T) {
+	for _, tc := range setStringCases {
+		testSetString(t, tc.s, tc.base)
+	}
+	for _, base := range []int{-1, 1, 63} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for base %d", base)
+				}
+			}()
+			new(Int).SetString("1", base)
+		}()
+	}
+}
+
+func FuzzSetStringCompare(f *testing.F) {
+	for _, tc := range setStringCases {
+		f.Add(tc.s, uint8(tc.base))
+	}
+	f.Fuzz(func(t *testing.T, s string, base uint8) {
+		if b := int(base); b == 0 || (b >= 2 && b <= 62) {
+			testSetString(t, s, b)
+		}
+	})
+}
+
+func BenchmarkText(b *testing.B) {
+	for _, base := range []int{7, 10, 16, 36} {
+		b.Run(fmt.Sprintf("base%d/uint256", base), func(b *testing.B) {
+			var buf [256]byte
+			b.ReportAllocs()
+			for j := 0; j < b.N; j += numSamples {
+				for i := 0; i < numSamples; i++ {
+					int256Samples[i].AppendText(buf[:0], base)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("base%d/big", base), func(b *testing.B) {
+			var buf [256]byte
+			b.ReportAllocs()
+			for j := 0; j < b.N; j += numSamples {
+				for i := 0; i < numSamples; i++ {
+					big256Samples[i].Append(buf[:0], base)
+				}
+			}
+		})
+	}
+}
+
 // TestSetBytes tests all setbyte-methods from 0 to overlong,
 // - verifies that all non-set bits are properly cleared
 // - verifies that overlong input is correctly cropped
@@ -338,271 +559,273 @@ func BenchmarkSetBytes(b *testing.B) {
 	})
 }
 
-func TestRlpEncode(t *testing.T) {
+// rlpTestCases are the canonical RLP encodings of a set of values, as
+// big-endian hex values (val) and their encoding (exp).
+var rlpTestCases = []struct {
+	val string
+	exp string
+}{
+	{"", "80"},
+	{"01", "01"},
+	{"02", "02"},
+	{"04", "04"},
+	{"08", "08"},
+	{"10", "10"},
+	{"20", "20"},
+	{"40", "40"},
+	{"80", "8180"},
+	{"0100", "820100"},
+	{"0200", "820200"},
+	{"0400", "820400"},
+	{"0800", "820800"},
+	{"1000", "821000"},
+	{"2000", "822000"},
+	{"4000", "824000"},
+	{"8000", "828000"},
+	{"010000", "83010000"},
+	{"020000", "83020000"},
+	{"040000", "83040000"},
+	{"080000", "83080000"},
+	{"100000", "83100000"},
+	{"200000", "83200000"},
+	{"400000", "83400000"},
+	{"800000", "83800000"},
+	{"01000000", "8401000000"},
+	{"02000000", "8402000000"},
+	{"04000000", "8404000000"},
+	{"08000000", "8408000000"},
+	{"10000000", "8410000000"},
+	{"20000000", "8420000000"},
+	{"40000000", "8440000000"},
+	{"80000000", "8480000000"},
+	{"0100000000", "850100000000"},
+	{"0200000000", "850200000000"},
+	{"0400000000", "850400000000"},
+	{"0800000000", "850800000000"},
+	{"1000000000", "851000000000"},
+	{"2000000000", "852000000000"},
+	{"4000000000", "854000000000"},
+	{"8000000000", "858000000000"},
+	{"010000000000", "86010000000000"},
+	{"020000000000", "86020000000000"},
+	{"040000000000", "86040000000000"},
+	{"080000000000", "86080000000000"},
+	{"100000000000", "86100000000000"},
+	{"200000000000", "86200000000000"},
+	{"400000000000", "86400000000000"},
+	{"800000000000", "86800000000000"},
+	{"01000000000000", "8701000000000000"},
+	{"02000000000000", "8702000000000000"},
+	{"04000000000000", "8704000000000000"},
+	{"08000000000000", "8708000000000000"},
+	{"10000000000000", "8710000000000000"},
+	{"20000000000000", "8720000000000000"},
+	{"40000000000000", "8740000000000000"},
+	{"80000000000000", "8780000000000000"},
+	{"0100000000000000", "880100000000000000"},
+	{"0200000000000000", "880200000000000000"},
+	{"0400000000000000", "880400000000000000"},
+	{"0800000000000000", "880800000000000000"},
+	{"1000000000000000", "881000000000000000"},
+	{"2000000000000000", "882000000000000000"},
+	{"4000000000000000", "884000000000000000"},
+	{"8000000000000000", "888000000000000000"},
+	{"010000000000000000", "89010000000000000000"},
+	{"020000000000000000", "89020000000000000000"},
+	{"040000000000000000", "89040000000000000000"},
+	{"080000000000000000", "89080000000000000000"},
+	{"100000000000000000", "89100000000000000000"},
+	{"200000000000000000", "89200000000000000000"},
+	{"400000000000000000", "89400000000000000000"},
+	{"800000000000000000", "89800000000000000000"},
+	{"01000000000000000000", "8a01000000000000000000"},
+	{"02000000000000000000", "8a02000000000000000000"},
+	{"04000000000000000000", "8a04000000000000000000"},
+	{"08000000000000000000", "8a08000000000000000000"},
+	{"10000000000000000000", "8a10000000000000000000"},
+	{"20000000000000000000", "8a20000000000000000000"},
+	{"40000000000000000000", "8a40000000000000000000"},
+	{"80000000000000000000", "8a80000000000000000000"},
+	{"0100000000000000000000", "8b0100000000000000000000"},
+	{"0200000000000000000000", "8b0200000000000000000000"},
+	{"0400000000000000000000", "8b0400000000000000000000"},
+	{"0800000000000000000000", "8b0800000000000000000000"},
+	{"1000000000000000000000", "8b1000000000000000000000"},
+	{"2000000000000000000000", "8b2000000000000000000000"},
+	{"4000000000000000000000", "8b4000000000000000000000"},
+	{"8000000000000000000000", "8b8000000000000000000000"},
+	{"010000000000000000000000", "8c010000000000000000000000"},
+	{"020000000000000000000000", "8c020000000000000000000000"},
+	{"040000000000000000000000", "8c040000000000000000000000"},
+	{"080000000000000000000000", "8c080000000000000000000000"},
+	{"100000000000000000000000", "8c100000000000000000000000"},
+	{"200000000000000000000000", "8c200000000000000000000000"},
+	{"400000000000000000000000", "8c400000000000000000000000"},
+	{"800000000000000000000000", "8c800000000000000000000000"},
+	{"01000000000000000000000000", "8d01000000000000000000000000"},
+	{"02000000000000000000000000", "8d02000000000000000000000000"},
+	{"04000000000000000000000000", "8d04000000000000000000000000"},
+	{"08000000000000000000000000", "8d08000000000000000000000000"},
+	{"10000000000000000000000000", "8d10000000000000000000000000"},
+	{"20000000000000000000000000", "8d20000000000000000000000000"},
+	{"40000000000000000000000000", "8d40000000000000000000000000"},
+	{"80000000000000000000000000", "8d80000000000000000000000000"},
+	{"0100000000000000000000000000", "8e0100000000000000000000000000"},
+	{"0200000000000000000000000000", "8e0200000000000000000000000000"},
+	{"0400000000000000000000000000", "8e0400000000000000000000000000"},
+	{"0800000000000000000000000000", "8e0800000000000000000000000000"},
+	{"1000000000000000000000000000", "8e1000000000000000000000000000"},
+	{"2000000000000000000000000000", "8e2000000000000000000000000000"},
+	{"4000000000000000000000000000", "8e4000000000000000000000000000"},
+	{"8000000000000000000000000000", "8e8000000000000000000000000000"},
+	{"010000000000000000000000000000", "8f010000000000000000000000000000"},
+	{"020000000000000000000000000000", "8f020000000000000000000000000000"},
+	{"040000000000000000000000000000", "8f040000000000000000000000000000"},
+	{"080000000000000000000000000000", "8f080000000000000000000000000000"},
+	{"100000000000000000000000000000", "8f100000000000000000000000000000"},
+	{"200000000000000000000000000000", "8f200000000000000000000000000000"},
+	{"400000000000000000000000000000", "8f400000000000000000000000000000"},
+	{"800000000000000000000000000000", "8f800000000000000000000000000000"},
+	{"01000000000000000000000000000000", "9001000000000000000000000000000000"},
+	{"02000000000000000000000000000000", "9002000000000000000000000000000000"},
+	{"04000000000000000000000000000000", "9004000000000000000000000000000000"},
+	{"08000000000000000000000000000000", "9008000000000000000000000000000000"},
+	{"10000000000000000000000000000000", "9010000000000000000000000000000000"},
+	{"20000000000000000000000000000000", "9020000000000000000000000000000000"},
+	{"40000000000000000000000000000000", "9040000000000000000000000000000000"},
+	{"80000000000000000000000000000000", "9080000000000000000000000000000000"},
+	{"0100000000000000000000000000000000", "910100000000000000000000000000000000"},
+	{"0200000000000000000000000000000000", "910200000000000000000000000000000000"},
+	{"0400000000000000000000000000000000", "910400000000000000000000000000000000"},
+	{"0800000000000000000000000000000000", "910800000000000000000000000000000000"},
+	{"1000000000000000000000000000000000", "911000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000", "912000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000", "914000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000", "918000000000000000000000000000000000"},
+	{"010000000000000000000000000000000000", "92010000000000000000000000000000000000"},
+	{"020000000000000000000000000000000000", "92020000000000000000000000000000000000"},
+	{"040000000000000000000000000000000000", "92040000000000000000000000000000000000"},
+	{"080000000000000000000000000000000000", "92080000000000000000000000000000000000"},
+	{"100000000000000000000000000000000000", "92100000000000000000000000000000000000"},
+	{"200000000000000000000000000000000000", "92200000000000000000000000000000000000"},
+	{"400000000000000000000000000000000000", "92400000000000000000000000000000000000"},
+	{"800000000000000000000000000000000000", "92800000000000000000000000000000000000"},
+	{"01000000000000000000000000000000000000", "9301000000000000000000000000000000000000"},
+	{"02000000000000000000000000000000000000", "9302000000000000000000000000000000000000"},
+	{"04000000000000000000000000000000000000", "9304000000000000000000000000000000000000"},
+	{"08000000000000000000000000000000000000", "9308000000000000000000000000000000000000"},
+	{"10000000000000000000000000000000000000", "9310000000000000000000000000000000000000"},
+	{"20000000000000000000000000000000000000", "9320000000000000000000000000000000000000"},
+	{"40000000000000000000000000000000000000", "9340000000000000000000000000000000000000"},
+	{"80000000000000000000000000000000000000", "9380000000000000000000000000000000000000"},
+	{"0100000000000000000000000000000000000000", "940100000000000000000000000000000000000000"},
+	{"0200000000000000000000000000000000000000", "940200000000000000000000000000000000000000"},
+	{"0400000000000000000000000000000000000000", "940400000000000000000000000000000000000000"},
+	{"0800000000000000000000000000000000000000", "940800000000000000000000000000000000000000"},
+	{"1000000000000000000000000000000000000000", "941000000000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000000000", "942000000000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000000000", "944000000000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000000000", "948000000000000000000000000000000000000000"},
+	{"010000000000000000000000000000000000000000", "95010000000000000000000000000000000000000000"},
+	{"020000000000000000000000000000000000000000", "95020000000000000000000000000000000000000000"},
+	{"040000000000000000000000000000000000000000", "95040000000000000000000000000000000000000000"},
+	{"080000000000000000000000000000000000000000", "95080000000000000000000000000000000000000000"},
+	{"100000000000000000000000000000000000000000", "95100000000000000000000000000000000000000000"},
+	{"200000000000000000000000000000000000000000", "95200000000000000000000000000000000000000000"},
+	{"400000000000000000000000000000000000000000", "95400000000000000000000000000000000000000000"},
+	{"800000000000000000000000000000000000000000", "95800000000000000000000000000000000000000000"},
+	{"01000000000000000000000000000000000000000000", "9601000000000000000000000000000000000000000000"},
+	{"02000000000000000000000000000000000000000000", "9602000000000000000000000000000000000000000000"},
+	{"04000000000000000000000000000000000000000000", "9604000000000000000000000000000000000000000000"},
+	{"08000000000000000000000000000000000000000000", "9608000000000000000000000000000000000000000000"},
+	{"10000000000000000000000000000000000000000000", "9610000000000000000000000000000000000000000000"},
+	{"20000000000000000000000000000000000000000000", "9620000000000000000000000000000000000000000000"},
+	{"40000000000000000000000000000000000000000000", "9640000000000000000000000000000000000000000000"},
+	{"80000000000000000000000000000000000000000000", "9680000000000000000000000000000000000000000000"},
+	{"0100000000000000000000000000000000000000000000", "970100000000000000000000000000000000000000000000"},
+	{"0200000000000000000000000000000000000000000000", "970200000000000000000000000000000000000000000000"},
+	{"0400000000000000000000000000000000000000000000", "970400000000000000000000000000000000000000000000"},
+	{"0800000000000000000000000000000000000000000000", "970800000000000000000000000000000000000000000000"},
+	{"1000000000000000000000000000000000000000000000", "971000000000000000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000000000000000", "972000000000000000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000000000000000", "974000000000000000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000000000000000", "978000000000000000000000000000000000000000000000"},
+	{"010000000000000000000000000000000000000000000000", "98010000000000000000000000000000000000000000000000"},
+	{"020000000000000000000000000000000000000000000000", "98020000000000000000000000000000000000000000000000"},
+	{"040000000000000000000000000000000000000000000000", "98040000000000000000000000000000000000000000000000"},
+	{"080000000000000000000000000000000000000000000000", "98080000000000000000000000000000000000000000000000"},
+	{"100000000000000000000000000000000000000000000000", "98100000000000000000000000000000000000000000000000"},
+	{"200000000000000000000000000000000000000000000000", "98200000000000000000000000000000000000000000000000"},
+	{"400000000000000000000000000000000000000000000000", "98400000000000000000000000000000000000000000000000"},
+	{"800000000000000000000000000000000000000000000000", "98800000000000000000000000000000000000000000000000"},
+	{"01000000000000000000000000000000000000000000000000", "9901000000000000000000000000000000000000000000000000"},
+	{"02000000000000000000000000000000000000000000000000", "9902000000000000000000000000000000000000000000000000"},
+	{"04000000000000000000000000000000000000000000000000", "9904000000000000000000000000000000000000000000000000"},
+	{"08000000000000000000000000000000000000000000000000", "9908000000000000000000000000000000000000000000000000"},
+	{"10000000000000000000000000000000000000000000000000", "9910000000000000000000000000000000000000000000000000"},
+	{"20000000000000000000000000000000000000000000000000", "9920000000000000000000000000000000000000000000000000"},
+	{"40000000000000000000000000000000000000000000000000", "9940000000000000000000000000000000000000000000000000"},
+	{"80000000000000000000000000000000000000000000000000", "9980000000000000000000000000000000000000000000000000"},
+	{"0100000000000000000000000000000000000000000000000000", "9a0100000000000000000000000000000000000000000000000000"},
+	{"0200000000000000000000000000000000000000000000000000", "9a0200000000000000000000000000000000000000000000000000"},
+	{"0400000000000000000000000000000000000000000000000000", "9a0400000000000000000000000000000000000000000000000000"},
+	{"0800000000000000000000000000000000000000000000000000", "9a0800000000000000000000000000000000000000000000000000"},
+	{"1000000000000000000000000000000000000000000000000000", "9a1000000000000000000000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000000000000000000000", "9a2000000000000000000000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000000000000000000000", "9a4000000000000000000000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000000000000000000000", "9a8000000000000000000000000000000000000000000000000000"},
+	{"010000000000000000000000000000000000000000000000000000", "9b010000000000000000000000000000000000000000000000000000"},
+	{"020000000000000000000000000000000000000000000000000000", "9b020000000000000000000000000000000000000000000000000000"},
+	{"040000000000000000000000000000000000000000000000000000", "9b040000000000000000000000000000000000000000000000000000"},
+	{"080000000000000000000000000000000000000000000000000000", "9b080000000000000000000000000000000000000000000000000000"},
+	{"100000000000000000000000000000000000000000000000000000", "9b100000000000000000000000000000000000000000000000000000"},
+	{"200000000000000000000000000000000000000000000000000000", "9b200000000000000000000000000000000000000000000000000000"},
+	{"400000000000000000000000000000000000000000000000000000", "9b400000000000000000000000000000000000000000000000000000"},
+	{"800000000000000000000000000000000000000000000000000000", "9b800000000000000000000000000000000000000000000000000000"},
+	{"01000000000000000000000000000000000000000000000000000000", "9c01000000000000000000000000000000000000000000000000000000"},
+	{"02000000000000000000000000000000000000000000000000000000", "9c02000000000000000000000000000000000000000000000000000000"},
+	{"04000000000000000000000000000000000000000000000000000000", "9c04000000000000000000000000000000000000000000000000000000"},
+	{"08000000000000000000000000000000000000000000000000000000", "9c08000000000000000000000000000000000000000000000000000000"},
+	{"10000000000000000000000000000000000000000000000000000000", "9c10000000000000000000000000000000000000000000000000000000"},
+	{"20000000000000000000000000000000000000000000000000000000", "9c20000000000000000000000000000000000000000000000000000000"},
+	{"40000000000000000000000000000000000000000000000000000000", "9c40000000000000000000000000000000000000000000000000000000"},
+	{"80000000000000000000000000000000000000000000000000000000", "9c80000000000000000000000000000000000000000000000000000000"},
+	{"0100000000000000000000000000000000000000000000000000000000", "9d0100000000000000000000000000000000000000000000000000000000"},
+	{"0200000000000000000000000000000000000000000000000000000000", "9d0200000000000000000000000000000000000000000000000000000000"},
+	{"0400000000000000000000000000000000000000000000000000000000", "9d0400000000000000000000000000000000000000000000000000000000"},
+	{"0800000000000000000000000000000000000000000000000000000000", "9d0800000000000000000000000000000000000000000000000000000000"},
+	{"1000000000000000000000000000000000000000000000000000000000", "9d1000000000000000000000000000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000000000000000000000000000", "9d2000000000000000000000000000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000000000000000000000000000", "9d4000000000000000000000000000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000000000000000000000000000", "9d8000000000000000000000000000000000000000000000000000000000"},
+	{"010000000000000000000000000000000000000000000000000000000000", "9e010000000000000000000000000000000000000000000000000000000000"},
+	{"020000000000000000000000000000000000000000000000000000000000", "9e020000000000000000000000000000000000000000000000000000000000"},
+	{"040000000000000000000000000000000000000000000000000000000000", "9e040000000000000000000000000000000000000000000000000000000000"},
+	{"080000000000000000000000000000000000000000000000000000000000", "9e080000000000000000000000000000000000000000000000000000000000"},
+	{"100000000000000000000000000000000000000000000000000000000000", "9e100000000000000000000000000000000000000000000000000000000000"},
+	{"200000000000000000000000000000000000000000000000000000000000", "9e200000000000000000000000000000000000000000000000000000000000"},
+	{"400000000000000000000000000000000000000000000000000000000000", "9e400000000000000000000000000000000000000000000000000000000000"},
+	{"800000000000000000000000000000000000000000000000000000000000", "9e800000000000000000000000000000000000000000000000000000000000"},
+	{"01000000000000000000000000000000000000000000000000000000000000", "9f01000000000000000000000000000000000000000000000000000000000000"},
+	{"02000000000000000000000000000000000000000000000000000000000000", "9f02000000000000000000000000000000000000000000000000000000000000"},
+	{"04000000000000000000000000000000000000000000000000000000000000", "9f04000000000000000000000000000000000000000000000000000000000000"},
+	{"08000000000000000000000000000000000000000000000000000000000000", "9f08000000000000000000000000000000000000000000000000000000000000"},
+	{"10000000000000000000000000000000000000000000000000000000000000", "9f10000000000000000000000000000000000000000000000000000000000000"},
+	{"20000000000000000000000000000000000000000000000000000000000000", "9f20000000000000000000000000000000000000000000000000000000000000"},
+	{"40000000000000000000000000000000000000000000000000000000000000", "9f40000000000000000000000000000000000000000000000000000000000000"},
+	{"80000000000000000000000000000000000000000000000000000000000000", "9f80000000000000000000000000000000000000000000000000000000000000"},
+	{"0100000000000000000000000000000000000000000000000000000000000000", "a00100000000000000000000000000000000000000000000000000000000000000"},
+	{"0200000000000000000000000000000000000000000000000000000000000000", "a00200000000000000000000000000000000000000000000000000000000000000"},
+	{"0400000000000000000000000000000000000000000000000000000000000000", "a00400000000000000000000000000000000000000000000000000000000000000"},
+	{"0800000000000000000000000000000000000000000000000000000000000000", "a00800000000000000000000000000000000000000000000000000000000000000"},
+	{"1000000000000000000000000000000000000000000000000000000000000000", "a01000000000000000000000000000000000000000000000000000000000000000"},
+	{"2000000000000000000000000000000000000000000000000000000000000000", "a02000000000000000000000000000000000000000000000000000000000000000"},
+	{"4000000000000000000000000000000000000000000000000000000000000000", "a04000000000000000000000000000000000000000000000000000000000000000"},
+	{"8000000000000000000000000000000000000000000000000000000000000000", "a08000000000000000000000000000000000000000000000000000000000000000"},
+}
 
-	type testcase struct {
-		val string
-		exp string
-	}
-	for i, tt := range []testcase{
-		{"", "80"},
-		{"01", "01"},
-		{"02", "02"},
-		{"04", "04"},
-		{"08", "08"},
-		{"10", "10"},
-		{"20", "20"},
-		{"40", "40"},
-		{"80", "8180"},
-		{"0100", "820100"},
-		{"0200", "820200"},
-		{"0400", "820400"},
-		{"0800", "820800"},
-		{"1000", "821000"},
-		{"2000", "822000"},
-		{"4000", "824000"},
-		{"8000", "828000"},
-		{"010000", "83010000"},
-		{"020000", "83020000"},
-		{"040000", "83040000"},
-		{"080000", "83080000"},
-		{"100000", "83100000"},
-		{"200000", "83200000"},
-		{"400000", "83400000"},
-		{"800000", "83800000"},
-		{"01000000", "8401000000"},
-		{"02000000", "8402000000"},
-		{"04000000", "8404000000"},
-		{"08000000", "8408000000"},
-		{"10000000", "8410000000"},
-		{"20000000", "8420000000"},
-		{"40000000", "8440000000"},
-		{"80000000", "8480000000"},
-		{"0100000000", "850100000000"},
-		{"0200000000", "850200000000"},
-		{"0400000000", "850400000000"},
-		{"0800000000", "850800000000"},
-		{"1000000000", "851000000000"},
-		{"2000000000", "852000000000"},
-		{"4000000000", "854000000000"},
-		{"8000000000", "858000000000"},
-		{"010000000000", "86010000000000"},
-		{"020000000000", "86020000000000"},
-		{"040000000000", "86040000000000"},
-		{"080000000000", "86080000000000"},
-		{"100000000000", "86100000000000"},
-		{"200000000000", "86200000000000"},
-		{"400000000000", "86400000000000"},
-		{"800000000000", "86800000000000"},
-		{"01000000000000", "8701000000000000"},
-		{"02000000000000", "8702000000000000"},
-		{"04000000000000", "8704000000000000"},
-		{"08000000000000", "8708000000000000"},
-		{"10000000000000", "8710000000000000"},
-		{"20000000000000", "8720000000000000"},
-		{"40000000000000", "8740000000000000"},
-		{"80000000000000", "8780000000000000"},
-		{"0100000000000000", "880100000000000000"},
-		{"0200000000000000", "880200000000000000"},
-		{"0400000000000000", "880400000000000000"},
-		{"0800000000000000", "880800000000000000"},
-		{"1000000000000000", "881000000000000000"},
-		{"2000000000000000", "882000000000000000"},
-		{"4000000000000000", "884000000000000000"},
-		{"8000000000000000", "888000000000000000"},
-		{"010000000000000000", "89010000000000000000"},
-		{"020000000000000000", "89020000000000000000"},
-		{"040000000000000000", "89040000000000000000"},
-		{"080000000000000000", "89080000000000000000"},
-		{"100000000000000000", "89100000000000000000"},
-		{"200000000000000000", "89200000000000000000"},
-		{"400000000000000000", "89400000000000000000"},
-		{"800000000000000000", "89800000000000000000"},
-		{"01000000000000000000", "8a01000000000000000000"},
-		{"02000000000000000000", "8a02000000000000000000"},
-		{"04000000000000000000", "8a04000000000000000000"},
-		{"08000000000000000000", "8a08000000000000000000"},
-		{"10000000000000000000", "8a10000000000000000000"},
-		{"20000000000000000000", "8a20000000000000000000"},
-		{"40000000000000000000", "8a40000000000000000000"},
-		{"80000000000000000000", "8a80000000000000000000"},
-		{"0100000000000000000000", "8b0100000000000000000000"},
-		{"0200000000000000000000", "8b0200000000000000000000"},
-		{"0400000000000000000000", "8b0400000000000000000000"},
-		{"0800000000000000000000", "8b0800000000000000000000"},
-		{"1000000000000000000000", "8b1000000000000000000000"},
-		{"2000000000000000000000", "8b2000000000000000000000"},
-		{"4000000000000000000000", "8b4000000000000000000000"},
-		{"8000000000000000000000", "8b8000000000000000000000"},
-		{"010000000000000000000000", "8c010000000000000000000000"},
-		{"020000000000000000000000", "8c020000000000000000000000"},
-		{"040000000000000000000000", "8c040000000000000000000000"},
-		{"080000000000000000000000", "8c080000000000000000000000"},
-		{"100000000000000000000000", "8c100000000000000000000000"},
-		{"200000000000000000000000", "8c200000000000000000000000"},
-		{"400000000000000000000000", "8c400000000000000000000000"},
-		{"800000000000000000000000", "8c800000000000000000000000"},
-		{"01000000000000000000000000", "8d01000000000000000000000000"},
-		{"02000000000000000000000000", "8d02000000000000000000000000"},
-		{"04000000000000000000000000", "8d04000000000000000000000000"},
-		{"08000000000000000000000000", "8d08000000000000000000000000"},
-		{"10000000000000000000000000", "8d10000000000000000000000000"},
-		{"20000000000000000000000000", "8d20000000000000000000000000"},
-		{"40000000000000000000000000", "8d40000000000000000000000000"},
-		{"80000000000000000000000000", "8d80000000000000000000000000"},
-		{"0100000000000000000000000000", "8e0100000000000000000000000000"},
-		{"0200000000000000000000000000", "8e0200000000000000000000000000"},
-		{"0400000000000000000000000000", "8e0400000000000000000000000000"},
-		{"0800000000000000000000000000", "8e0800000000000000000000000000"},
-		{"1000000000000000000000000000", "8e1000000000000000000000000000"},
-		{"2000000000000000000000000000", "8e2000000000000000000000000000"},
-		{"4000000000000000000000000000", "8e4000000000000000000000000000"},
-		{"8000000000000000000000000000", "8e8000000000000000000000000000"},
-		{"010000000000000000000000000000", "8f010000000000000000000000000000"},
-		{"020000000000000000000000000000", "8f020000000000000000000000000000"},
-		{"040000000000000000000000000000", "8f040000000000000000000000000000"},
-		{"080000000000000000000000000000", "8f080000000000000000000000000000"},
-		{"100000000000000000000000000000", "8f100000000000000000000000000000"},
-		{"200000000000000000000000000000", "8f200000000000000000000000000000"},
-		{"400000000000000000000000000000", "8f400000000000000000000000000000"},
-		{"800000000000000000000000000000", "8f800000000000000000000000000000"},
-		{"01000000000000000000000000000000", "9001000000000000000000000000000000"},
-		{"02000000000000000000000000000000", "9002000000000000000000000000000000"},
-		{"04000000000000000000000000000000", "9004000000000000000000000000000000"},
-		{"08000000000000000000000000000000", "9008000000000000000000000000000000"},
-		{"10000000000000000000000000000000", "9010000000000000000000000000000000"},
-		{"20000000000000000000000000000000", "9020000000000000000000000000000000"},
-		{"40000000000000000000000000000000", "9040000000000000000000000000000000"},
-		{"80000000000000000000000000000000", "9080000000000000000000000000000000"},
-		{"0100000000000000000000000000000000", "910100000000000000000000000000000000"},
-		{"0200000000000000000000000000000000", "910200000000000000000000000000000000"},
-		{"0400000000000000000000000000000000", "910400000000000000000000000000000000"},
-		{"0800000000000000000000000000000000", "910800000000000000000000000000000000"},
-		{"1000000000000000000000000000000000", "911000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000", "912000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000", "914000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000", "918000000000000000000000000000000000"},
-		{"010000000000000000000000000000000000", "92010000000000000000000000000000000000"},
-		{"020000000000000000000000000000000000", "92020000000000000000000000000000000000"},
-		{"040000000000000000000000000000000000", "92040000000000000000000000000000000000"},
-		{"080000000000000000000000000000000000", "92080000000000000000000000000000000000"},
-		{"100000000000000000000000000000000000", "92100000000000000000000000000000000000"},
-		{"200000000000000000000000000000000000", "92200000000000000000000000000000000000"},
-		{"400000000000000000000000000000000000", "92400000000000000000000000000000000000"},
-		{"800000000000000000000000000000000000", "92800000000000000000000000000000000000"},
-		{"01000000000000000000000000000000000000", "9301000000000000000000000000000000000000"},
-		{"02000000000000000000000000000000000000", "9302000000000000000000000000000000000000"},
-		{"04000000000000000000000000000000000000", "9304000000000000000000000000000000000000"},
-		{"08000000000000000000000000000000000000", "9308000000000000000000000000000000000000"},
-		{"10000000000000000000000000000000000000", "9310000000000000000000000000000000000000"},
-		{"20000000000000000000000000000000000000", "9320000000000000000000000000000000000000"},
-		{"40000000000000000000000000000000000000", "9340000000000000000000000000000000000000"},
-		{"80000000000000000000000000000000000000", "9380000000000000000000000000000000000000"},
-		{"0100000000000000000000000000000000000000", "940100000000000000000000000000000000000000"},
-		{"0200000000000000000000000000000000000000", "940200000000000000000000000000000000000000"},
-		{"0400000000000000000000000000000000000000", "940400000000000000000000000000000000000000"},
-		{"0800000000000000000000000000000000000000", "940800000000000000000000000000000000000000"},
-		{"1000000000000000000000000000000000000000", "941000000000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000000000", "942000000000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000000000", "944000000000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000000000", "948000000000000000000000000000000000000000"},
-		{"010000000000000000000000000000000000000000", "95010000000000000000000000000000000000000000"},
-		{"020000000000000000000000000000000000000000", "95020000000000000000000000000000000000000000"},
-		{"040000000000000000000000000000000000000000", "95040000000000000000000000000000000000000000"},
-		{"080000000000000000000000000000000000000000", "95080000000000000000000000000000000000000000"},
-		{"100000000000000000000000000000000000000000", "95100000000000000000000000000000000000000000"},
-		{"200000000000000000000000000000000000000000", "95200000000000000000000000000000000000000000"},
-		{"400000000000000000000000000000000000000000", "95400000000000000000000000000000000000000000"},
-		{"800000000000000000000000000000000000000000", "95800000000000000000000000000000000000000000"},
-		{"01000000000000000000000000000000000000000000", "9601000000000000000000000000000000000000000000"},
-		{"02000000000000000000000000000000000000000000", "9602000000000000000000000000000000000000000000"},
-		{"04000000000000000000000000000000000000000000", "9604000000000000000000000000000000000000000000"},
-		{"08000000000000000000000000000000000000000000", "9608000000000000000000000000000000000000000000"},
-		{"10000000000000000000000000000000000000000000", "9610000000000000000000000000000000000000000000"},
-		{"20000000000000000000000000000000000000000000", "9620000000000000000000000000000000000000000000"},
-		{"40000000000000000000000000000000000000000000", "9640000000000000000000000000000000000000000000"},
-		{"80000000000000000000000000000000000000000000", "9680000000000000000000000000000000000000000000"},
-		{"0100000000000000000000000000000000000000000000", "970100000000000000000000000000000000000000000000"},
-		{"0200000000000000000000000000000000000000000000", "970200000000000000000000000000000000000000000000"},
-		{"0400000000000000000000000000000000000000000000", "970400000000000000000000000000000000000000000000"},
-		{"0800000000000000000000000000000000000000000000", "970800000000000000000000000000000000000000000000"},
-		{"1000000000000000000000000000000000000000000000", "971000000000000000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000000000000000", "972000000000000000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000000000000000", "974000000000000000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000000000000000", "978000000000000000000000000000000000000000000000"},
-		{"010000000000000000000000000000000000000000000000", "98010000000000000000000000000000000000000000000000"},
-		{"020000000000000000000000000000000000000000000000", "98020000000000000000000000000000000000000000000000"},
-		{"040000000000000000000000000000000000000000000000", "98040000000000000000000000000000000000000000000000"},
-		{"080000000000000000000000000000000000000000000000", "98080000000000000000000000000000000000000000000000"},
-		{"100000000000000000000000000000000000000000000000", "98100000000000000000000000000000000000000000000000"},
-		{"200000000000000000000000000000000000000000000000", "98200000000000000000000000000000000000000000000000"},
-		{"400000000000000000000000000000000000000000000000", "98400000000000000000000000000000000000000000000000"},
-		{"800000000000000000000000000000000000000000000000", "98800000000000000000000000000000000000000000000000"},
-		{"01000000000000000000000000000000000000000000000000", "9901000000000000000000000000000000000000000000000000"},
-		{"02000000000000000000000000000000000000000000000000", "9902000000000000000000000000000000000000000000000000"},
-		{"04000000000000000000000000000000000000000000000000", "9904000000000000000000000000000000000000000000000000"},
-		{"08000000000000000000000000000000000000000000000000", "9908000000000000000000000000000000000000000000000000"},
-		{"10000000000000000000000000000000000000000000000000", "9910000000000000000000000000000000000000000000000000"},
-		{"20000000000000000000000000000000000000000000000000", "9920000000000000000000000000000000000000000000000000"},
-		{"40000000000000000000000000000000000000000000000000", "9940000000000000000000000000000000000000000000000000"},
-		{"80000000000000000000000000000000000000000000000000", "9980000000000000000000000000000000000000000000000000"},
-		{"0100000000000000000000000000000000000000000000000000", "9a0100000000000000000000000000000000000000000000000000"},
-		{"0200000000000000000000000000000000000000000000000000", "9a0200000000000000000000000000000000000000000000000000"},
-		{"0400000000000000000000000000000000000000000000000000", "9a0400000000000000000000000000000000000000000000000000"},
-		{"0800000000000000000000000000000000000000000000000000", "9a0800000000000000000000000000000000000000000000000000"},
-		{"1000000000000000000000000000000000000000000000000000", "9a1000000000000000000000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000000000000000000000", "9a2000000000000000000000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000000000000000000000", "9a4000000000000000000000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000000000000000000000", "9a8000000000000000000000000000000000000000000000000000"},
-		{"010000000000000000000000000000000000000000000000000000", "9b010000000000000000000000000000000000000000000000000000"},
-		{"020000000000000000000000000000000000000000000000000000", "9b020000000000000000000000000000000000000000000000000000"},
-		{"040000000000000000000000000000000000000000000000000000", "9b040000000000000000000000000000000000000000000000000000"},
-		{"080000000000000000000000000000000000000000000000000000", "9b080000000000000000000000000000000000000000000000000000"},
-		{"100000000000000000000000000000000000000000000000000000", "9b100000000000000000000000000000000000000000000000000000"},
-		{"200000000000000000000000000000000000000000000000000000", "9b200000000000000000000000000000000000000000000000000000"},
-		{"400000000000000000000000000000000000000000000000000000", "9b400000000000000000000000000000000000000000000000000000"},
-		{"800000000000000000000000000000000000000000000000000000", "9b800000000000000000000000000000000000000000000000000000"},
-		{"01000000000000000000000000000000000000000000000000000000", "9c01000000000000000000000000000000000000000000000000000000"},
-		{"02000000000000000000000000000000000000000000000000000000", "9c02000000000000000000000000000000000000000000000000000000"},
-		{"04000000000000000000000000000000000000000000000000000000", "9c04000000000000000000000000000000000000000000000000000000"},
-		{"08000000000000000000000000000000000000000000000000000000", "9c08000000000000000000000000000000000000000000000000000000"},
-		{"10000000000000000000000000000000000000000000000000000000", "9c10000000000000000000000000000000000000000000000000000000"},
-		{"20000000000000000000000000000000000000000000000000000000", "9c20000000000000000000000000000000000000000000000000000000"},
-		{"40000000000000000000000000000000000000000000000000000000", "9c40000000000000000000000000000000000000000000000000000000"},
-		{"80000000000000000000000000000000000000000000000000000000", "9c80000000000000000000000000000000000000000000000000000000"},
-		{"0100000000000000000000000000000000000000000000000000000000", "9d0100000000000000000000000000000000000000000000000000000000"},
-		{"0200000000000000000000000000000000000000000000000000000000", "9d0200000000000000000000000000000000000000000000000000000000"},
-		{"0400000000000000000000000000000000000000000000000000000000", "9d0400000000000000000000000000000000000000000000000000000000"},
-		{"0800000000000000000000000000000000000000000000000000000000", "9d0800000000000000000000000000000000000000000000000000000000"},
-		{"1000000000000000000000000000000000000000000000000000000000", "9d1000000000000000000000000000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000000000000000000000000000", "9d2000000000000000000000000000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000000000000000000000000000", "9d4000000000000000000000000000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000000000000000000000000000", "9d8000000000000000000000000000000000000000000000000000000000"},
-		{"010000000000000000000000000000000000000000000000000000000000", "9e010000000000000000000000000000000000000000000000000000000000"},
-		{"020000000000000000000000000000000000000000000000000000000000", "9e020000000000000000000000000000000000000000000000000000000000"},
-		{"040000000000000000000000000000000000000000000000000000000000", "9e040000000000000000000000000000000000000000000000000000000000"},
-		{"080000000000000000000000000000000000000000000000000000000000", "9e080000000000000000000000000000000000000000000000000000000000"},
-		{"100000000000000000000000000000000000000000000000000000000000", "9e100000000000000000000000000000000000000000000000000000000000"},
-		{"200000000000000000000000000000000000000000000000000000000000", "9e200000000000000000000000000000000000000000000000000000000000"},
-		{"400000000000000000000000000000000000000000000000000000000000", "9e400000000000000000000000000000000000000000000000000000000000"},
-		{"800000000000000000000000000000000000000000000000000000000000", "9e800000000000000000000000000000000000000000000000000000000000"},
-		{"01000000000000000000000000000000000000000000000000000000000000", "9f01000000000000000000000000000000000000000000000000000000000000"},
-		{"02000000000000000000000000000000000000000000000000000000000000", "9f02000000000000000000000000000000000000000000000000000000000000"},
-		{"04000000000000000000000000000000000000000000000000000000000000", "9f04000000000000000000000000000000000000000000000000000000000000"},
-		{"08000000000000000000000000000000000000000000000000000000000000", "9f08000000000000000000000000000000000000000000000000000000000000"},
-		{"10000000000000000000000000000000000000000000000000000000000000", "9f10000000000000000000000000000000000000000000000000000000000000"},
-		{"20000000000000000000000000000000000000000000000000000000000000", "9f20000000000000000000000000000000000000000000000000000000000000"},
-		{"40000000000000000000000000000000000000000000000000000000000000", "9f40000000000000000000000000000000000000000000000000000000000000"},
-		{"80000000000000000000000000000000000000000000000000000000000000", "9f80000000000000000000000000000000000000000000000000000000000000"},
-		{"0100000000000000000000000000000000000000000000000000000000000000", "a00100000000000000000000000000000000000000000000000000000000000000"},
-		{"0200000000000000000000000000000000000000000000000000000000000000", "a00200000000000000000000000000000000000000000000000000000000000000"},
-		{"0400000000000000000000000000000000000000000000000000000000000000", "a00400000000000000000000000000000000000000000000000000000000000000"},
-		{"0800000000000000000000000000000000000000000000000000000000000000", "a00800000000000000000000000000000000000000000000000000000000000000"},
-		{"1000000000000000000000000000000000000000000000000000000000000000", "a01000000000000000000000000000000000000000000000000000000000000000"},
-		{"2000000000000000000000000000000000000000000000000000000000000000", "a02000000000000000000000000000000000000000000000000000000000000000"},
-		{"4000000000000000000000000000000000000000000000000000000000000000", "a04000000000000000000000000000000000000000000000000000000000000000"},
-		{"8000000000000000000000000000000000000000000000000000000000000000", "a08000000000000000000000000000000000000000000000000000000000000000"},
-	} {
+func TestRlpEncode(t *testing.T) {
+	for i, tt := range rlpTestCases {
 		z := new(Int).SetBytes(hex2Bytes(tt.val))
 		var b bytes.Buffer
 		w := bufio.NewWriter(&b)
@@ -629,6 +852,104 @@ func TestRlpEncode(t *testing.T) {
 	}
 }
 
+// rawStream is a minimal RLPStream which hands out one value at a time.
+type rawStream struct {
+	vals [][]byte
+}
+
+func (s *rawStream) Raw() ([]byte, error) {
+	if len(s.vals) == 0 {
+		return nil, io.EOF
+	}
+	v := s.vals[0]
+	s.vals = s.vals[1:]
+	return v, nil
+}
+
+func TestRlpDecode(t *testing.T) {
+	for i, tt := range rlpTestCases {
+		exp := new(Int).SetBytes(hex2Bytes(tt.val))
+		enc := hex2Bytes(tt.exp)
+		// Trailing data must be handed back untouched
+		input := append(append([]byte{}, enc...), 0xde, 0xad)
+		z := new(Int).SetAllOne()
+		rest, err := z.DecodeRLPBytes(input)
+		if err != nil {
+			t.Fatalf("testcase %d: %v", i, err)
+		}
+		if !z.Eq(exp) {
+			t.Fatalf("testcase %d got %x exp %x", i, z, exp)
+		}
+		if !bytes.Equal(rest, []byte{0xde, 0xad}) {
+			t.Fatalf("testcase %d wrong rest: %x", i, rest)
+		}
+		// Round-trip via a stream
+		z = new(Int).SetAllOne()
+		if err := z.DecodeRLP(&rawStream{vals: [][]byte{enc}}); err != nil {
+			t.Fatalf("testcase %d: %v", i, err)
+		}
+		if !z.Eq(exp) {
+			t.Fatalf("testcase %d got %x exp %x", i, z, exp)
+		}
+		var b bytes.Buffer
+		if err := z.EncodeRLP(&b); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b.Bytes(), enc) {
+			t.Fatalf("testcase %d round-trip got %x exp %x", i, b.Bytes(), enc)
+		}
+	}
+	// Max value
+	{
+		enc := hex2Bytes("a0ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+		z := new(Int)
+		if _, err := z.DecodeRLPBytes(enc); err != nil {
+			t.Fatal(err)
+		}
+		if exp := new(Int).SetAllOne(); !z.Eq(exp) {
+			t.Fatalf("got %x exp %x", z, exp)
+		}
+	}
+	// Stream errors are passed through
+	if err := new(Int).DecodeRLP(&rawStream{}); err != io.EOF {
+		t.Fatalf("want io.EOF, got %v", err)
+	}
+}
+
+func TestRlpDecodeNonCanonical(t *testing.T) {
+	for i, tt := range []struct {
+		input string
+		err   error
+	}{
+		{"", io.ErrUnexpectedEOF},
+		{"00", ErrRLPCanonInt},
+		{"8100", ErrRLPCanonSize},
+		{"8101", ErrRLPCanonSize},
+		{"817f", ErrRLPCanonSize},
+		{"820001", ErrRLPCanonInt},
+		{"a000ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ErrRLPCanonInt},
+		{"81", ErrRLPValueTooLarge},
+		{"8201", ErrRLPValueTooLarge},
+		{"a0ffff", ErrRLPValueTooLarge},
+		{"a101ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ErrRLPUint256Range},
+		{"b838ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ErrRLPUint256Range},
+		{"c0", ErrRLPExpectedString},
+		{"c180", ErrRLPExpectedString},
+		{"f800", ErrRLPExpectedString},
+	} {
+		z := NewInt(1337)
+		if _, err := z.DecodeRLPBytes(hex2Bytes(tt.input)); err != tt.err {
+			t.Errorf("testcase %d (%v): got error %v, want %v", i, tt.input, err, tt.err)
+		}
+		if z.Uint64() != 1337 || !z.IsUint64() {
+			t.Errorf("testcase %d (%v): value modified on error: %x", i, tt.input, z)
+		}
+		if err := z.DecodeRLP(&rawStream{vals: [][]byte{hex2Bytes(tt.input)}}); err != tt.err {
+			t.Errorf("testcase %d (%v): got stream error %v, want %v", i, tt.input, err, tt.err)
+		}
+	}
+}
+
 type nilWriter struct{}
 
 func (*nilWriter) Write(p []byte) (n int, err error) {
@@ -11,8 +11,40 @@ import (
 
 const twoPow256Sub1 = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
 
+// Dec returns the decimal representation of z.
 func (z *Int) Dec() string {
-	return z.ToBig().String()
+	var buf [78]byte // 2^256-1 has 78 decimal digits
+	return string(z.AppendDec(buf[:0]))
+}
+
+// AppendDec appends the decimal representation of z to dst, and returns
+// the extended buffer.
+func (z *Int) AppendDec(dst []byte) []byte {
+	if z.IsUint64() {
+		return strconv.AppendUint(dst, z[0], 10)
+	}
+	// The value is split into chunks of 19 decimal digits, by repeated
+	// division by 10^19. Since 10^19 >= 2^63, it is already normalized for
+	// the 2-by-1 division, and the same reciprocal can be used throughout.
+	var (
+		buf [78]byte
+		pos = len(buf)
+		x   = *z
+		rem uint64
+	)
+	for !x.IsUint64() {
+		rem = 0
+		for i := 3; i >= 0; i-- {
+			x[i], rem = udivrem2by1(rem, x[i], tenPow19, tenPow19Reciprocal)
+		}
+		for j := 0; j < 19; j++ {
+			pos--
+			buf[pos] = byte('0' + rem%10)
+			rem /= 10
+		}
+	}
+	dst = strconv.AppendUint(dst, x[0], 10)
+	return append(dst, buf[pos:]...)
 }
 
 // FromDecimal is a convenience-constructor to create an Int from a
@@ -59,6 +91,11 @@ func (z *Int) SetFromDecimal(s string) (err error) {
 	return ErrBig256Range
 }
 
+const tenPow19 = 10000000000000000000
+
+// tenPow19Reciprocal is the reciprocal of 10^19, as used by udivrem2by1.
+var tenPow19Reciprocal = reciprocal2by1(tenPow19)
+
 // multipliers holds the values that are needed for fromDecimal
 var multipliers = [5]*Int{
 	nil,                             // represents first round, no multiplication needed
@@ -68,6 +105,15 @@ var multipliers = [5]*Int{
 	{0, 8607968719199866880, 532749306367912313, 1593091911132452277},   // 10 ^ 76
 }
 
+// tenPowers holds the powers of ten that fit in an Int, 10^0 through 10^77.
+var tenPowers = func() (t [78]Int) {
+	t[0].SetOne()
+	for i := 1; i < len(t); i++ {
+		t[i].Mul(&t[i-1], &Int{10})
+	}
+	return t
+}()
+
 // fromDecimal is a helper function to only ever be called via SetFromDecimal
 // this function takes a string and chunks it up, calling ParseUint on it up to 5 times
 // these chunks are then multiplied by the proper power of 10, then added together.
@@ -110,6 +110,44 @@ func TestStringScan(t *testing.T) {
 	}
 }
 
+func TestDec(t *testing.T) {
+	check := func(z *Int) {
+		t.Helper()
+		want := z.ToBig().String()
+		if have := z.Dec(); have != want {
+			t.Fatalf("Dec: want %v, have %v", want, have)
+		}
+		if have := string(z.AppendDec([]byte("abc"))); have != "abc"+want {
+			t.Fatalf("AppendDec: want %v, have %v", "abc"+want, have)
+		}
+		if have := fmt.Sprintf("%d", z); have != want {
+			t.Fatalf("Format: want %v, have %v", want, have)
+		}
+	}
+	// Powers of ten, and their neighbours
+	ten := NewInt(10)
+	for p := NewInt(1); ; {
+		check(p)
+		check(new(Int).AddUint64(p, 1))
+		check(new(Int).SubUint64(p, 1))
+		if _, overflow := p.MulOverflow(p, ten); overflow {
+			break
+		}
+	}
+	// Powers of two, and their neighbours
+	for i := uint(0); i < 256; i++ {
+		p := new(Int).Lsh(NewInt(1), i)
+		check(p)
+		check(new(Int).AddUint64(p, 1))
+		check(new(Int).SubUint64(p, 1))
+	}
+	check(new(Int).SetAllOne())
+	for i := range int256Samples {
+		check(&int256Samples[i])
+		check(&int128Samples[i])
+	}
+}
+
 func FuzzBase10StringCompare(f *testing.F) {
 	for _, tc := range cases {
 		f.Add(tc)
@@ -0,0 +1,94 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// Divisor is a divisor prepared for repeated division of many values, such
+// as a total supply or 10^18. The normalization of the divisor and the
+// reciprocal of its top word, which Div and Mod compute on every call, are
+// computed once by NewDivisor.
+type Divisor struct {
+	d          Int    // the divisor
+	dn         Int    // d << shift, with the top bit of its top word set
+	dLen       int    // number of significant words of d, 0 for d == 0
+	shift      uint   // leading zeros of the top word of d
+	reciprocal uint64 // reciprocal of the top word of dn
+}
+
+// NewDivisor returns a Divisor for d. A zero divisor is allowed, and divides
+// everything to 0, as Div and Mod do.
+func NewDivisor(d *Int) *Divisor {
+	dv := &Divisor{d: *d}
+	for i := len(d) - 1; i >= 0; i-- {
+		if d[i] != 0 {
+			dv.dLen = i + 1
+			break
+		}
+	}
+	if dv.dLen == 0 {
+		return dv
+	}
+	dv.shift = uint(bits.LeadingZeros64(d[dv.dLen-1]))
+	for i := dv.dLen - 1; i > 0; i-- {
+		dv.dn[i] = (d[i] << dv.shift) | (d[i-1] >> (64 - dv.shift))
+	}
+	dv.dn[0] = d[0] << dv.shift
+	dv.reciprocal = reciprocal2by1(dv.dn[dv.dLen-1])
+	return dv
+}
+
+// Int returns the value of the divisor.
+func (dv *Divisor) Int() *Int {
+	return dv.d.Clone()
+}
+
+// udivrem divides x by the non-zero divisor, and produces both quotient and
+// remainder. The quotient is stored in provided quot.
+func (dv *Divisor) udivrem(quot, x *Int) (rem Int) {
+	uLen := 4
+	for uLen > 0 && x[uLen-1] == 0 {
+		uLen--
+	}
+	if uLen < dv.dLen {
+		return *x
+	}
+	return udivremNormalized(quot[:], x[:uLen], dv.dn[:dv.dLen], dv.shift, dv.reciprocal)
+}
+
+// Div sets z to the quotient x/d, where d is the divisor, and returns z.
+// If d == 0, z is set to 0
+func (dv *Divisor) Div(z, x *Int) *Int {
+	if dv.dLen == 0 {
+		return z.Clear()
+	}
+	var quot Int
+	dv.udivrem(&quot, x)
+	return z.Set(&quot)
+}
+
+// Mod sets z to the modulus x%d, where d is the divisor, and returns z.
+// If d == 0, z is set to 0 (OBS: differs from the big.Int)
+func (dv *Divisor) Mod(z, x *Int) *Int {
+	if dv.dLen == 0 {
+		return z.Clear()
+	}
+	var quot Int
+	*z = dv.udivrem(&quot, x)
+	return z
+}
+
+// DivMod sets z to the quotient x div d and m to the modulus x mod d, where d
+// is the divisor, and returns the pair (z, m).
+// If d == 0, both z and m are set to 0 (OBS: differs from the big.Int)
+func (dv *Divisor) DivMod(z, x, m *Int) (*Int, *Int) {
+	if dv.dLen == 0 {
+		return z.Clear(), m.Clear()
+	}
+	var quot Int
+	*m = dv.udivrem(&quot, x)
+	*z = quot
+	return z, m
+}
@@ -0,0 +1,66 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+func testDivisor(t *testing.T, x, d *Int) {
+	t.Helper()
+	dv := NewDivisor(d)
+	if !dv.Int().Eq(d) {
+		t.Fatalf("NewDivisor(%#x).Int(): have %#x", d, dv.Int())
+	}
+	wantQuo, wantRem := new(big.Int), new(big.Int)
+	if !d.IsZero() {
+		wantQuo.QuoRem(x.ToBig(), d.ToBig(), wantRem)
+	}
+	if z := new(Int); !checkEq(wantQuo, dv.Div(z, x)) {
+		t.Fatalf("Divisor(%#x).Div(%#x): have %#x want %#x", d, x, z, wantQuo)
+	}
+	if z := x.Clone(); !checkEq(wantQuo, dv.Div(z, z)) {
+		t.Fatalf("Divisor(%#x).Div(%#x) with z == x: have %#x want %#x", d, x, z, wantQuo)
+	}
+	if z := new(Int); !checkEq(wantRem, dv.Mod(z, x)) {
+		t.Fatalf("Divisor(%#x).Mod(%#x): have %#x want %#x", d, x, z, wantRem)
+	}
+	if z := x.Clone(); !checkEq(wantRem, dv.Mod(z, z)) {
+		t.Fatalf("Divisor(%#x).Mod(%#x) with z == x: have %#x want %#x", d, x, z, wantRem)
+	}
+	z, m := x.Clone(), x.Clone()
+	dv.DivMod(z, z, m)
+	if !checkEq(wantQuo, z) || !checkEq(wantRem, m) {
+		t.Fatalf("Divisor(%#x).DivMod(%#x): have %#x, %#x want %#x, %#x", d, x, z, m, wantQuo, wantRem)
+	}
+}
+
+func TestDivisor(t *testing.T) {
+	for _, tc := range binTestCases {
+		b1, _ := new(big.Int).SetString(tc[0], 0)
+		b2, _ := new(big.Int).SetString(tc[1], 0)
+		x, _ := FromBig(b1)
+		d, _ := FromBig(b2)
+		testDivisor(t, x, d)
+		testDivisor(t, d, x)
+	}
+	for i := 0; i < 10000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, d, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		// Divisors of all the sizes, 1 to 4 words.
+		testDivisor(t, x, d)
+		testDivisor(t, x, new(Int).Rsh(d, 64+uint(d[0]%64)))
+		testDivisor(t, x, new(Int).Rsh(d, 128+uint(d[0]%64)))
+		testDivisor(t, x, new(Int).Rsh(d, 192+uint(d[0]%64)))
+		testDivisor(t, new(Int).Rsh(x, uint(x[0]%256)), d)
+	}
+}
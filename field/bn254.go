@@ -0,0 +1,226 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package field
+
+import "github.com/holiman/uint256"
+
+var (
+	// bn254PMod is the prime p of the BN254 base field.
+	bn254PMod  = uint256.Int{0x3c208c16d87cfd47, 0x97816a916871ca8d, 0xb85045b68181585d, 0x30644e72e131a029}
+	bn254P     = uint256.NewModulus(&bn254PMod)
+	bn254PMont = uint256.NewMontgomery(&bn254PMod)
+	// bn254PInvExp is p-2, for inversion by Fermat's little theorem.
+	bn254PInvExp = new(uint256.Int).SubUint64(&bn254PMod, 2)
+	// bn254PSqrtExp is (p+1)/4, for square roots as p = 3 mod 4.
+	bn254PSqrtExp = new(uint256.Int).Rsh(new(uint256.Int).AddUint64(&bn254PMod, 1), 2)
+
+	// bn254RMod is the order r of the BN254 groups.
+	bn254RMod  = uint256.Int{0x43e1f593f0000001, 0x2833e84879b97091, 0xb85045b68181585d, 0x30644e72e131a029}
+	bn254R     = uint256.NewModulus(&bn254RMod)
+	bn254RMont = uint256.NewMontgomery(&bn254RMod)
+	// bn254RInvExp is r-2, for inversion by Fermat's little theorem.
+	bn254RInvExp = new(uint256.Int).SubUint64(&bn254RMod, 2)
+)
+
+// BN254P is an element of the base field of BN254 (alt_bn128), the integers
+// modulo the prime p.
+type BN254P struct {
+	v uint256.Int // canonical residue in Montgomery form
+}
+
+// BN254PModulus returns the prime p of the field.
+func BN254PModulus() *uint256.Int {
+	return bn254PMod.Clone()
+}
+
+// SetInt sets z to x mod p, and returns z.
+func (z *BN254P) SetInt(x *uint256.Int) *BN254P {
+	bn254PMont.ToMont(&z.v, x)
+	return z
+}
+
+// SetUint64 sets z to x, and returns z.
+func (z *BN254P) SetUint64(x uint64) *BN254P {
+	return z.SetInt(new(uint256.Int).SetUint64(x))
+}
+
+// Int returns the value of z, as a residue below p.
+func (z *BN254P) Int() *uint256.Int {
+	return bn254PMont.FromMont(new(uint256.Int), &z.v)
+}
+
+// Set sets z to x, and returns z.
+func (z *BN254P) Set(x *BN254P) *BN254P {
+	z.v = x.v
+	return z
+}
+
+// IsZero returns true if z == 0.
+func (z *BN254P) IsZero() bool {
+	return z.v.IsZero()
+}
+
+// Eq returns true if z == x.
+func (z *BN254P) Eq(x *BN254P) bool {
+	return z.v.Eq(&x.v)
+}
+
+// Add sets z to the sum x+y, and returns z.
+func (z *BN254P) Add(x, y *BN254P) *BN254P {
+	bn254P.Add(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sub sets z to the difference x-y, and returns z.
+func (z *BN254P) Sub(x, y *BN254P) *BN254P {
+	bn254P.Sub(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Neg sets z to -x, and returns z.
+func (z *BN254P) Neg(x *BN254P) *BN254P {
+	bn254P.Neg(&z.v, &x.v)
+	return z
+}
+
+// Mul sets z to the product x*y, and returns z.
+func (z *BN254P) Mul(x, y *BN254P) *BN254P {
+	bn254PMont.MulMont(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sqr sets z to the square x*x, and returns z.
+func (z *BN254P) Sqr(x *BN254P) *BN254P {
+	bn254PMont.SqrMont(&z.v, &x.v)
+	return z
+}
+
+// Exp sets z = x**exponent, and returns z. As with uint256.Int.ExpMod, x**0
+// is 1.
+func (z *BN254P) Exp(x *BN254P, exponent *uint256.Int) *BN254P {
+	bn254PMont.ExpMont(&z.v, &x.v, exponent)
+	return z
+}
+
+// Inv sets z to the multiplicative inverse 1/x, and returns z and true.
+// If x == 0, z is unmodified and false is returned.
+func (z *BN254P) Inv(x *BN254P) (*BN254P, bool) {
+	if x.IsZero() {
+		return z, false
+	}
+	return z.Exp(x, bn254PInvExp), true
+}
+
+// Sqrt sets z to a square root of x, and returns z and true. If x is not a
+// square, z is unmodified and false is returned.
+func (z *BN254P) Sqrt(x *BN254P) (*BN254P, bool) {
+	var r, sq BN254P
+	r.Exp(x, bn254PSqrtExp)
+	if !sq.Sqr(&r).Eq(x) {
+		return z, false
+	}
+	*z = r
+	return z, true
+}
+
+// BN254R is an element of the scalar field of BN254 (alt_bn128), the
+// integers modulo the group order r.
+type BN254R struct {
+	v uint256.Int // canonical residue in Montgomery form
+}
+
+// BN254RModulus returns the group order r, the prime of the field.
+func BN254RModulus() *uint256.Int {
+	return bn254RMod.Clone()
+}
+
+// SetInt sets z to x mod r, and returns z.
+func (z *BN254R) SetInt(x *uint256.Int) *BN254R {
+	bn254RMont.ToMont(&z.v, x)
+	return z
+}
+
+// SetUint64 sets z to x, and returns z.
+func (z *BN254R) SetUint64(x uint64) *BN254R {
+	return z.SetInt(new(uint256.Int).SetUint64(x))
+}
+
+// Int returns the value of z, as a residue below r.
+func (z *BN254R) Int() *uint256.Int {
+	return bn254RMont.FromMont(new(uint256.Int), &z.v)
+}
+
+// Set sets z to x, and returns z.
+func (z *BN254R) Set(x *BN254R) *BN254R {
+	z.v = x.v
+	return z
+}
+
+// IsZero returns true if z == 0.
+func (z *BN254R) IsZero() bool {
+	return z.v.IsZero()
+}
+
+// Eq returns true if z == x.
+func (z *BN254R) Eq(x *BN254R) bool {
+	return z.v.Eq(&x.v)
+}
+
+// Add sets z to the sum x+y, and returns z.
+func (z *BN254R) Add(x, y *BN254R) *BN254R {
+	bn254R.Add(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sub sets z to the difference x-y, and returns z.
+func (z *BN254R) Sub(x, y *BN254R) *BN254R {
+	bn254R.Sub(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Neg sets z to -x, and returns z.
+func (z *BN254R) Neg(x *BN254R) *BN254R {
+	bn254R.Neg(&z.v, &x.v)
+	return z
+}
+
+// Mul sets z to the product x*y, and returns z.
+func (z *BN254R) Mul(x, y *BN254R) *BN254R {
+	bn254RMont.MulMont(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sqr sets z to the square x*x, and returns z.
+func (z *BN254R) Sqr(x *BN254R) *BN254R {
+	bn254RMont.SqrMont(&z.v, &x.v)
+	return z
+}
+
+// Exp sets z = x**exponent, and returns z. As with uint256.Int.ExpMod, x**0
+// is 1.
+func (z *BN254R) Exp(x *BN254R, exponent *uint256.Int) *BN254R {
+	bn254RMont.ExpMont(&z.v, &x.v, exponent)
+	return z
+}
+
+// Inv sets z to the multiplicative inverse 1/x, and returns z and true.
+// If x == 0, z is unmodified and false is returned.
+func (z *BN254R) Inv(x *BN254R) (*BN254R, bool) {
+	if x.IsZero() {
+		return z, false
+	}
+	return z.Exp(x, bn254RInvExp), true
+}
+
+// Sqrt sets z to a square root of x, and returns z and true. If x is not a
+// square, z is unmodified and false is returned. As r = 1 mod 2^28, the root
+// is found with the generic uint256.Int.ModSqrt, outside Montgomery form.
+func (z *BN254R) Sqrt(x *BN254R) (*BN254R, bool) {
+	var r uint256.Int
+	if _, ok := r.ModSqrt(x.Int(), &bn254RMod); !ok {
+		return z, false
+	}
+	return z.SetInt(&r), true
+}
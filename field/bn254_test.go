@@ -0,0 +1,122 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package field
+
+import (
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+var bn254POps = fieldOps{
+	p: BN254PModulus(),
+	reduce: func(x *uint256.Int) *uint256.Int {
+		return new(BN254P).SetInt(x).Int()
+	},
+	add: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Add(z, new(BN254P).SetInt(y)).Int()
+	},
+	sub: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Sub(z, new(BN254P).SetInt(y)).Int()
+	},
+	mul: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Mul(z, new(BN254P).SetInt(y)).Int()
+	},
+	neg: func(x *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Neg(z).Int()
+	},
+	sqr: func(x *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Sqr(z).Int()
+	},
+	exp: func(x, e *uint256.Int) *uint256.Int {
+		z := new(BN254P).SetInt(x)
+		return z.Exp(z, e).Int()
+	},
+	inv: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(BN254P).SetInt(x)
+		_, ok := z.Inv(z)
+		return z.Int(), ok
+	},
+	sqrt: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(BN254P).SetInt(x)
+		_, ok := z.Sqrt(z)
+		return z.Int(), ok
+	},
+}
+
+var bn254ROps = fieldOps{
+	p: BN254RModulus(),
+	reduce: func(x *uint256.Int) *uint256.Int {
+		return new(BN254R).SetInt(x).Int()
+	},
+	add: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Add(z, new(BN254R).SetInt(y)).Int()
+	},
+	sub: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Sub(z, new(BN254R).SetInt(y)).Int()
+	},
+	mul: func(x, y *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Mul(z, new(BN254R).SetInt(y)).Int()
+	},
+	neg: func(x *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Neg(z).Int()
+	},
+	sqr: func(x *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Sqr(z).Int()
+	},
+	exp: func(x, e *uint256.Int) *uint256.Int {
+		z := new(BN254R).SetInt(x)
+		return z.Exp(z, e).Int()
+	},
+	inv: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(BN254R).SetInt(x)
+		_, ok := z.Inv(z)
+		return z.Int(), ok
+	},
+	sqrt: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(BN254R).SetInt(x)
+		_, ok := z.Sqrt(z)
+		return z.Int(), ok
+	},
+}
+
+func TestBN254P(t *testing.T) {
+	testFieldValues(t, &bn254POps)
+
+	// The generator G1 = (1, 2) is on the curve y^2 = x^3 + 3.
+	var x, y, rhs BN254P
+	x.SetUint64(1)
+	y.SetUint64(2)
+	rhs.Sqr(&x).Mul(&rhs, &x).Add(&rhs, new(BN254P).SetUint64(3))
+	if lhs := new(BN254P).Sqr(&y); !lhs.Eq(&rhs) {
+		t.Fatalf("y^2 = %#x, x^3 + 3 = %#x", lhs.Int(), rhs.Int())
+	}
+	// -1 is not a square, as p = 3 mod 4.
+	if _, ok := new(BN254P).Sqrt(new(BN254P).Neg(&x)); ok {
+		t.Fatal("Sqrt(-1): expected no root")
+	}
+}
+
+func TestBN254R(t *testing.T) {
+	testFieldValues(t, &bn254ROps)
+
+	// 5 is not a square, so 5^((r-1)/2) = -1.
+	var g, minusOne BN254R
+	e := new(uint256.Int).Rsh(BN254RModulus(), 1)
+	g.SetUint64(5).Exp(&g, e)
+	if !g.Eq(minusOne.Neg(new(BN254R).SetUint64(1))) {
+		t.Fatalf("5^((r-1)/2) = %#x, want r-1", g.Int())
+	}
+}
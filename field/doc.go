@@ -0,0 +1,19 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package field implements arithmetic in the prime fields of the secp256k1
+// and BN254 (alt_bn128) elliptic curves, for their base fields and for the
+// scalar fields given by the group orders.
+//
+// Each field has its own element type, whose zero value is the element 0.
+// Elements are always reduced, and are converted from and to uint256.Int with
+// SetInt and Int. Like the uint256.Int methods, the arithmetic methods set z
+// to the result and return z, and z may alias the arguments.
+//
+// The reduction is chosen for the form of each modulus: the secp256k1 prime
+// 2^256 - 2^32 - 977 is reduced with pseudo-Mersenne folding, the BN254
+// fields keep their elements in Montgomery form, and the secp256k1 order,
+// which is not close enough to 2^256 for folding to pay off, uses Barrett
+// reduction.
+package field
@@ -0,0 +1,129 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package field
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+// fieldOps wraps the methods of a field type on uint256.Int values, so that
+// testField checks all the fields alike. The wrappers reuse z as the first
+// argument, to check aliasing.
+type fieldOps struct {
+	p             *uint256.Int
+	reduce        func(x *uint256.Int) *uint256.Int
+	add, sub, mul func(x, y *uint256.Int) *uint256.Int
+	neg, sqr      func(x *uint256.Int) *uint256.Int
+	exp           func(x, e *uint256.Int) *uint256.Int
+	inv, sqrt     func(x *uint256.Int) (*uint256.Int, bool)
+}
+
+// testField checks the field operations on x, y and the exponent e against
+// big.Int.
+func testField(t *testing.T, f *fieldOps, x, y, e *uint256.Int) {
+	t.Helper()
+	var (
+		p      = f.p.ToBig()
+		bx, by = x.ToBig(), y.ToBig()
+	)
+	check := func(name string, have *uint256.Int, want *big.Int) {
+		t.Helper()
+		if want.Mod(want, p).Cmp(have.ToBig()) != 0 {
+			t.Fatalf("%s(%#x, %#x) mod %#x: have %#x want %#x", name, x, y, p, have, want)
+		}
+	}
+	check("SetInt", f.reduce(x), new(big.Int).Set(bx))
+	check("Add", f.add(x, y), new(big.Int).Add(bx, by))
+	check("Sub", f.sub(x, y), new(big.Int).Sub(bx, by))
+	check("Neg", f.neg(x), new(big.Int).Neg(bx))
+	check("Mul", f.mul(x, y), new(big.Int).Mul(bx, by))
+	check("Sqr", f.sqr(x), new(big.Int).Mul(bx, bx))
+	check("Exp", f.exp(x, e), new(big.Int).Exp(bx, e.ToBig(), p))
+
+	want := new(big.Int).ModInverse(bx, p)
+	if z, ok := f.inv(x); ok != (want != nil) || (ok && want.Cmp(z.ToBig()) != 0) {
+		t.Fatalf("Inv(%#x) mod %#x: have %#x, %v want %#x", x, p, z, ok, want)
+	}
+	// Either root is fine, so check the square of the result.
+	want = new(big.Int).ModSqrt(bx, p)
+	z, ok := f.sqrt(x)
+	if ok != (want != nil) {
+		t.Fatalf("Sqrt(%#x) mod %#x: have %#x, %v want %#x", x, p, z, ok, want)
+	}
+	if ok {
+		check("Sqrt", f.sqr(z), new(big.Int).Set(bx))
+	}
+}
+
+// randInt returns a random value of random bit length.
+func randInt(rnd *rand.Rand) *uint256.Int {
+	x := &uint256.Int{rnd.Uint64(), rnd.Uint64(), rnd.Uint64(), rnd.Uint64()}
+	return x.Rsh(x, uint(rnd.Intn(256)))
+}
+
+func testFieldValues(t *testing.T, f *fieldOps) {
+	var (
+		rnd = rand.New(rand.NewSource(1))
+		max = new(uint256.Int).SetAllOne()
+		one = uint256.NewInt(1)
+	)
+	edges := []*uint256.Int{
+		new(uint256.Int),
+		one,
+		uint256.NewInt(2),
+		new(uint256.Int).Sub(f.p, one),
+		f.p,
+		new(uint256.Int).Add(f.p, one),
+		new(uint256.Int).Rsh(f.p, 1),
+		max,
+	}
+	for _, x := range edges {
+		for _, y := range edges {
+			testField(t, f, x, y, y)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		testField(t, f, randInt(rnd), randInt(rnd), randInt(rnd))
+	}
+}
+
+// benchValues returns two residues mod p with all words set.
+func benchValues(p *uint256.Int) (x, y *uint256.Int) {
+	return new(uint256.Int).Rsh(p, 1), new(uint256.Int).Sub(p, uint256.NewInt(3))
+}
+
+func benchmarkMulMod(b *testing.B, p *uint256.Int) {
+	x, y := benchValues(p)
+	for i := 0; i < b.N; i++ {
+		x.MulMod(x, y, p)
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	b.Run("secp256k1p/MulMod", func(b *testing.B) { benchmarkMulMod(b, Secp256k1PModulus()) })
+	b.Run("secp256k1p/Secp256k1P", func(b *testing.B) {
+		var x, y Secp256k1P
+		bx, by := benchValues(Secp256k1PModulus())
+		x.SetInt(bx)
+		y.SetInt(by)
+		for i := 0; i < b.N; i++ {
+			x.Mul(&x, &y)
+		}
+	})
+	b.Run("bn254p/MulMod", func(b *testing.B) { benchmarkMulMod(b, BN254PModulus()) })
+	b.Run("bn254p/BN254P", func(b *testing.B) {
+		var x, y BN254P
+		bx, by := benchValues(BN254PModulus())
+		x.SetInt(bx)
+		y.SetInt(by)
+		for i := 0; i < b.N; i++ {
+			x.Mul(&x, &y)
+		}
+	})
+}
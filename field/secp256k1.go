@@ -0,0 +1,288 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package field
+
+import (
+	"math/bits"
+
+	"github.com/holiman/uint256"
+)
+
+// secp256k1C is 2^256 - p, for the secp256k1 prime p.
+const secp256k1C = 0x1000003d1
+
+var (
+	// secp256k1PMod is the secp256k1 prime p = 2^256 - 2^32 - 977.
+	secp256k1PMod = uint256.Int{0xfffffffefffffc2f, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff}
+	secp256k1P    = uint256.NewModulus(&secp256k1PMod)
+	// secp256k1PInvExp is p-2, for inversion by Fermat's little theorem.
+	secp256k1PInvExp = new(uint256.Int).SubUint64(&secp256k1PMod, 2)
+	// secp256k1PSqrtExp is (p+1)/4, for square roots as p = 3 mod 4.
+	secp256k1PSqrtExp = new(uint256.Int).Rsh(new(uint256.Int).AddUint64(&secp256k1PMod, 1), 2)
+
+	// secp256k1NMod is the order n of the secp256k1 group.
+	secp256k1NMod = uint256.Int{0xbfd25e8cd0364141, 0xbaaedce6af48a03b, 0xfffffffffffffffe, 0xffffffffffffffff}
+	secp256k1N    = uint256.NewModulus(&secp256k1NMod)
+)
+
+// Secp256k1P is an element of the base field of secp256k1, the integers
+// modulo the prime p = 2^256 - 2^32 - 977.
+type Secp256k1P struct {
+	v uint256.Int // canonical residue
+}
+
+// Secp256k1PModulus returns the prime p of the field.
+func Secp256k1PModulus() *uint256.Int {
+	return secp256k1PMod.Clone()
+}
+
+// SetInt sets z to x mod p, and returns z.
+func (z *Secp256k1P) SetInt(x *uint256.Int) *Secp256k1P {
+	secp256k1P.Reduce(&z.v, x)
+	return z
+}
+
+// SetUint64 sets z to x, and returns z.
+func (z *Secp256k1P) SetUint64(x uint64) *Secp256k1P {
+	z.v.SetUint64(x)
+	return z
+}
+
+// Int returns the value of z, as a residue below p.
+func (z *Secp256k1P) Int() *uint256.Int {
+	return z.v.Clone()
+}
+
+// Set sets z to x, and returns z.
+func (z *Secp256k1P) Set(x *Secp256k1P) *Secp256k1P {
+	z.v = x.v
+	return z
+}
+
+// IsZero returns true if z == 0.
+func (z *Secp256k1P) IsZero() bool {
+	return z.v.IsZero()
+}
+
+// Eq returns true if z == x.
+func (z *Secp256k1P) Eq(x *Secp256k1P) bool {
+	return z.v.Eq(&x.v)
+}
+
+// Add sets z to the sum x+y, and returns z.
+func (z *Secp256k1P) Add(x, y *Secp256k1P) *Secp256k1P {
+	secp256k1P.Add(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sub sets z to the difference x-y, and returns z.
+func (z *Secp256k1P) Sub(x, y *Secp256k1P) *Secp256k1P {
+	secp256k1P.Sub(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Neg sets z to -x, and returns z.
+func (z *Secp256k1P) Neg(x *Secp256k1P) *Secp256k1P {
+	secp256k1P.Neg(&z.v, &x.v)
+	return z
+}
+
+// reduce sets z to (hi*2^256 + lo) mod p. As 2^256 = c mod p, for the small
+// c = 2^32 + 977, the high half is folded into the low one by multiplying it
+// by c, twice, instead of dividing by p.
+func (z *Secp256k1P) reduce(hi, lo *uint256.Int) {
+	var (
+		r             uint256.Int
+		top, carry, c uint64
+		h, l          uint64
+	)
+	// r + top*2^256 = lo + hi*c, with top <= c.
+	for i := 0; i < 4; i++ {
+		h, l = bits.Mul64(hi[i], secp256k1C)
+		l, c = bits.Add64(l, top, 0)
+		r[i], carry = bits.Add64(lo[i], l, carry)
+		top = h + c
+	}
+	top += carry
+
+	// r + carry*2^256 = r + top*c, with top*c < 2^67.
+	h, l = bits.Mul64(top, secp256k1C)
+	r[0], carry = bits.Add64(r[0], l, 0)
+	r[1], carry = bits.Add64(r[1], h, carry)
+	r[2], carry = bits.Add64(r[2], 0, carry)
+	r[3], carry = bits.Add64(r[3], 0, carry)
+
+	// Folding a carry makes r = c + (r mod 2^256), where r mod 2^256 < 2^67.
+	if carry != 0 {
+		r[0], carry = bits.Add64(r[0], secp256k1C, 0)
+		r[1] += carry
+	}
+	// r < 2^256 < 2p
+	if !r.Lt(&secp256k1PMod) {
+		r.Sub(&r, &secp256k1PMod)
+	}
+	z.v = r
+}
+
+// Mul sets z to the product x*y, and returns z.
+func (z *Secp256k1P) Mul(x, y *Secp256k1P) *Secp256k1P {
+	hi, lo := uint256.MulFull(&x.v, &y.v)
+	z.reduce(&hi, &lo)
+	return z
+}
+
+// Sqr sets z to the square x*x, and returns z.
+func (z *Secp256k1P) Sqr(x *Secp256k1P) *Secp256k1P {
+	hi, lo := uint256.MulFull(&x.v, &x.v)
+	z.reduce(&hi, &lo)
+	return z
+}
+
+// Exp sets z = x**exponent, and returns z. As with uint256.Int.ExpMod, x**0
+// is 1.
+func (z *Secp256k1P) Exp(x *Secp256k1P, exponent *uint256.Int) *Secp256k1P {
+	var (
+		table [16]Secp256k1P // table[i] = x**i
+		res   Secp256k1P
+	)
+	table[0].SetUint64(1)
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i].Mul(&table[i-1], &table[1])
+	}
+	// Left-to-right exponentiation with a fixed 4-bit window.
+	res = table[0]
+	for i := (exponent.BitLen()+3)/4 - 1; i >= 0; i-- {
+		res.Sqr(&res)
+		res.Sqr(&res)
+		res.Sqr(&res)
+		res.Sqr(&res)
+		if w := (exponent[i/16] >> (uint(i%16) * 4)) & 15; w != 0 {
+			res.Mul(&res, &table[w])
+		}
+	}
+	*z = res
+	return z
+}
+
+// Inv sets z to the multiplicative inverse 1/x, and returns z and true.
+// If x == 0, z is unmodified and false is returned.
+func (z *Secp256k1P) Inv(x *Secp256k1P) (*Secp256k1P, bool) {
+	if x.IsZero() {
+		return z, false
+	}
+	return z.Exp(x, secp256k1PInvExp), true
+}
+
+// Sqrt sets z to a square root of x, and returns z and true. If x is not a
+// square, z is unmodified and false is returned.
+func (z *Secp256k1P) Sqrt(x *Secp256k1P) (*Secp256k1P, bool) {
+	var r, sq Secp256k1P
+	r.Exp(x, secp256k1PSqrtExp)
+	if !sq.Sqr(&r).Eq(x) {
+		return z, false
+	}
+	*z = r
+	return z, true
+}
+
+// Secp256k1N is an element of the scalar field of secp256k1, the integers
+// modulo the group order n.
+type Secp256k1N struct {
+	v uint256.Int // canonical residue
+}
+
+// Secp256k1NModulus returns the group order n, the prime of the field.
+func Secp256k1NModulus() *uint256.Int {
+	return secp256k1NMod.Clone()
+}
+
+// SetInt sets z to x mod n, and returns z.
+func (z *Secp256k1N) SetInt(x *uint256.Int) *Secp256k1N {
+	secp256k1N.Reduce(&z.v, x)
+	return z
+}
+
+// SetUint64 sets z to x, and returns z.
+func (z *Secp256k1N) SetUint64(x uint64) *Secp256k1N {
+	z.v.SetUint64(x)
+	return z
+}
+
+// Int returns the value of z, as a residue below n.
+func (z *Secp256k1N) Int() *uint256.Int {
+	return z.v.Clone()
+}
+
+// Set sets z to x, and returns z.
+func (z *Secp256k1N) Set(x *Secp256k1N) *Secp256k1N {
+	z.v = x.v
+	return z
+}
+
+// IsZero returns true if z == 0.
+func (z *Secp256k1N) IsZero() bool {
+	return z.v.IsZero()
+}
+
+// Eq returns true if z == x.
+func (z *Secp256k1N) Eq(x *Secp256k1N) bool {
+	return z.v.Eq(&x.v)
+}
+
+// Add sets z to the sum x+y, and returns z.
+func (z *Secp256k1N) Add(x, y *Secp256k1N) *Secp256k1N {
+	secp256k1N.Add(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sub sets z to the difference x-y, and returns z.
+func (z *Secp256k1N) Sub(x, y *Secp256k1N) *Secp256k1N {
+	secp256k1N.Sub(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Neg sets z to -x, and returns z.
+func (z *Secp256k1N) Neg(x *Secp256k1N) *Secp256k1N {
+	secp256k1N.Neg(&z.v, &x.v)
+	return z
+}
+
+// Mul sets z to the product x*y, and returns z.
+func (z *Secp256k1N) Mul(x, y *Secp256k1N) *Secp256k1N {
+	secp256k1N.Mul(&z.v, &x.v, &y.v)
+	return z
+}
+
+// Sqr sets z to the square x*x, and returns z.
+func (z *Secp256k1N) Sqr(x *Secp256k1N) *Secp256k1N {
+	secp256k1N.Sqr(&z.v, &x.v)
+	return z
+}
+
+// Exp sets z = x**exponent, and returns z. As with uint256.Int.ExpMod, x**0
+// is 1.
+func (z *Secp256k1N) Exp(x *Secp256k1N, exponent *uint256.Int) *Secp256k1N {
+	secp256k1N.Exp(&z.v, &x.v, exponent)
+	return z
+}
+
+// Inv sets z to the multiplicative inverse 1/x, and returns z and true.
+// If x == 0, z is unmodified and false is returned.
+func (z *Secp256k1N) Inv(x *Secp256k1N) (*Secp256k1N, bool) {
+	if _, ok := secp256k1N.Inv(&z.v, &x.v); !ok {
+		return z, false
+	}
+	return z, true
+}
+
+// Sqrt sets z to a square root of x, and returns z and true. If x is not a
+// square, z is unmodified and false is returned.
+func (z *Secp256k1N) Sqrt(x *Secp256k1N) (*Secp256k1N, bool) {
+	if _, ok := z.v.ModSqrt(&x.v, &secp256k1NMod); !ok {
+		return z, false
+	}
+	return z, true
+}
@@ -0,0 +1,119 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package field
+
+import (
+	"testing"
+
+	"github.com/holiman/uint256"
+)
+
+var secp256k1POps = fieldOps{
+	p: Secp256k1PModulus(),
+	reduce: func(x *uint256.Int) *uint256.Int {
+		return new(Secp256k1P).SetInt(x).Int()
+	},
+	add: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Add(z, new(Secp256k1P).SetInt(y)).Int()
+	},
+	sub: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Sub(z, new(Secp256k1P).SetInt(y)).Int()
+	},
+	mul: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Mul(z, new(Secp256k1P).SetInt(y)).Int()
+	},
+	neg: func(x *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Neg(z).Int()
+	},
+	sqr: func(x *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Sqr(z).Int()
+	},
+	exp: func(x, e *uint256.Int) *uint256.Int {
+		z := new(Secp256k1P).SetInt(x)
+		return z.Exp(z, e).Int()
+	},
+	inv: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(Secp256k1P).SetInt(x)
+		_, ok := z.Inv(z)
+		return z.Int(), ok
+	},
+	sqrt: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(Secp256k1P).SetInt(x)
+		_, ok := z.Sqrt(z)
+		return z.Int(), ok
+	},
+}
+
+var secp256k1NOps = fieldOps{
+	p: Secp256k1NModulus(),
+	reduce: func(x *uint256.Int) *uint256.Int {
+		return new(Secp256k1N).SetInt(x).Int()
+	},
+	add: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Add(z, new(Secp256k1N).SetInt(y)).Int()
+	},
+	sub: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Sub(z, new(Secp256k1N).SetInt(y)).Int()
+	},
+	mul: func(x, y *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Mul(z, new(Secp256k1N).SetInt(y)).Int()
+	},
+	neg: func(x *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Neg(z).Int()
+	},
+	sqr: func(x *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Sqr(z).Int()
+	},
+	exp: func(x, e *uint256.Int) *uint256.Int {
+		z := new(Secp256k1N).SetInt(x)
+		return z.Exp(z, e).Int()
+	},
+	inv: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(Secp256k1N).SetInt(x)
+		_, ok := z.Inv(z)
+		return z.Int(), ok
+	},
+	sqrt: func(x *uint256.Int) (*uint256.Int, bool) {
+		z := new(Secp256k1N).SetInt(x)
+		_, ok := z.Sqrt(z)
+		return z.Int(), ok
+	},
+}
+
+func TestSecp256k1P(t *testing.T) {
+	testFieldValues(t, &secp256k1POps)
+
+	// The generator G = (x, y) is on the curve y^2 = x^3 + 7.
+	var (
+		gx, _ = uint256.FromHex("0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+		gy, _ = uint256.FromHex("0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+		x, y  Secp256k1P
+		rhs   Secp256k1P
+		root  Secp256k1P
+	)
+	x.SetInt(gx)
+	y.SetInt(gy)
+	rhs.Sqr(&x).Mul(&rhs, &x).Add(&rhs, new(Secp256k1P).SetUint64(7))
+	if lhs := new(Secp256k1P).Sqr(&y); !lhs.Eq(&rhs) {
+		t.Fatalf("y^2 = %#x, x^3 + 7 = %#x", lhs.Int(), rhs.Int())
+	}
+	if _, ok := root.Sqrt(&rhs); !ok || !(root.Eq(&y) || root.Eq(new(Secp256k1P).Neg(&y))) {
+		t.Fatalf("Sqrt(x^3 + 7) = %#x, %v want ±%#x", root.Int(), ok, gy)
+	}
+}
+
+func TestSecp256k1N(t *testing.T) {
+	testFieldValues(t, &secp256k1NOps)
+}
@@ -0,0 +1,51 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// MulFull returns the full 512-bit product x*y, as its high and low 256-bit
+// halves, so that x*y = hi*2^256 + lo.
+func MulFull(x, y *Int) (hi, lo Int) {
+	p := umul(x, y)
+	copy(lo[:], p[:4])
+	copy(hi[:], p[4:])
+	return hi, lo
+}
+
+// MulAddFull returns the full 512-bit result of x*y + a, as its high and low
+// 256-bit halves, so that x*y + a = hi*2^256 + lo. The result always fits,
+// as (2^256-1)^2 + 2^256-1 < 2^512.
+func MulAddFull(x, y, a *Int) (hi, lo Int) {
+	p := umul(x, y)
+	var carry uint64
+	lo[0], carry = bits.Add64(p[0], a[0], 0)
+	lo[1], carry = bits.Add64(p[1], a[1], carry)
+	lo[2], carry = bits.Add64(p[2], a[2], carry)
+	lo[3], carry = bits.Add64(p[3], a[3], carry)
+	hi[0], carry = bits.Add64(p[4], 0, carry)
+	hi[1], carry = bits.Add64(p[5], 0, carry)
+	hi[2], carry = bits.Add64(p[6], 0, carry)
+	hi[3] = p[7] + carry
+	return hi, lo
+}
+
+// DivFull divides the 512-bit value hi*2^256 + lo by d, and returns the
+// quotient and the remainder. The quotient fits in 256 bits iff hi < d;
+// otherwise overflow is true and quo holds the low 256 bits of the quotient.
+// The remainder is always exact.
+// If d == 0, quo and rem are 0 (OBS: differs from the big.Int)
+func DivFull(hi, lo, d *Int) (quo, rem Int, overflow bool) {
+	if d.IsZero() {
+		return quo, rem, false
+	}
+	var (
+		u    = [8]uint64{lo[0], lo[1], lo[2], lo[3], hi[0], hi[1], hi[2], hi[3]}
+		quot [8]uint64
+	)
+	rem = udivrem(quot[:], u[:], d)
+	copy(quo[:], quot[:4])
+	return quo, rem, (quot[4] | quot[5] | quot[6] | quot[7]) != 0
+}
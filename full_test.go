@@ -0,0 +1,90 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// fromFull returns hi*2^256 + lo.
+func fromFull(hi, lo *Int) *big.Int {
+	b := new(big.Int).Lsh(hi.ToBig(), 256)
+	return b.Add(b, lo.ToBig())
+}
+
+// testFull checks MulFull, MulAddFull and DivFull on the operands against
+// big.Int, and DivFull against MulAddFull.
+func testFull(t *testing.T, x, y, a, d *Int) {
+	t.Helper()
+	xyWant := new(big.Int).Mul(x.ToBig(), y.ToBig())
+	hi, lo := MulFull(x, y)
+	if have := fromFull(&hi, &lo); have.Cmp(xyWant) != 0 {
+		t.Fatalf("MulFull(%#x, %#x): have %#x want %#x", x, y, have, xyWant)
+	}
+	want := new(big.Int).Add(xyWant, a.ToBig())
+	hi, lo = MulAddFull(x, y, a)
+	if have := fromFull(&hi, &lo); have.Cmp(want) != 0 {
+		t.Fatalf("MulAddFull(%#x, %#x, %#x): have %#x want %#x", x, y, a, have, want)
+	}
+
+	// Divide x*y + a both by d, and by x, leaving the remainder of a.
+	for _, d := range []*Int{d, x} {
+		wantQuo, wantRem := new(big.Int), new(big.Int)
+		if !d.IsZero() {
+			wantQuo.QuoRem(want, d.ToBig(), wantRem)
+		}
+		wantOverflow := wantQuo.Cmp(tt256m1) > 0
+		quo, rem, overflow := DivFull(&hi, &lo, d)
+		if !checkEq(wantQuo, &quo) || !checkEq(wantRem, &rem) || overflow != wantOverflow {
+			t.Fatalf("DivFull(%#x, %#x, %#x): have %#x, %#x, %v want %#x, %#x, %v",
+				&hi, &lo, d, &quo, &rem, overflow, u256(wantQuo), wantRem, wantOverflow)
+		}
+		if !d.IsZero() && overflow != !hi.Lt(d) {
+			t.Fatalf("DivFull(%#x, %#x, %#x): overflow %v, with hi < d %v", &hi, &lo, d, overflow, hi.Lt(d))
+		}
+	}
+}
+
+func TestFull(t *testing.T) {
+	max := new(Int).SetAllOne()
+	testFull(t, max, max, max, max)
+	testFull(t, max, max, max, NewInt(1))
+	testFull(t, max, max, max, new(Int))
+	testFull(t, max, max, new(Int), new(Int).SubUint64(max, 1))
+	testFull(t, new(Int), max, max, NewInt(3))
+	for _, tc := range binTestCases {
+		b1, _ := new(big.Int).SetString(tc[0], 0)
+		b2, _ := new(big.Int).SetString(tc[1], 0)
+		x, _ := FromBig(b1)
+		y, _ := FromBig(b2)
+		testFull(t, x, y, y, y)
+		testFull(t, x, x, y, y)
+		testFull(t, x, y, max, x)
+	}
+	for i := 0; i < 10000; i++ {
+		var ops [4]*Int
+		for j := range ops {
+			_, x, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			ops[j] = x
+		}
+		testFull(t, ops[0], ops[1], ops[2], ops[3])
+	}
+}
+
+func FuzzFull(f *testing.F) {
+	max := new(Int).SetAllOne().Bytes()
+	f.Add(max, max, max, max)
+	f.Add([]byte{1}, max, []byte{}, []byte{2})
+	f.Fuzz(func(t *testing.T, x, y, a, d []byte) {
+		if len(x) > 32 || len(y) > 32 || len(a) > 32 || len(d) > 32 {
+			return
+		}
+		testFull(t, new(Int).SetBytes(x), new(Int).SetBytes(y), new(Int).SetBytes(a), new(Int).SetBytes(d))
+	})
+}
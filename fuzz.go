@@ -274,6 +274,14 @@ func intAddMod(f1, f2, f3, f4 *Int) *Int {
 	return f1.AddMod(f2, f3, f4)
 }
 
+func bigintExpMod(b1, b2, b3, b4 *big.Int) *big.Int {
+	return b1.Exp(b2, b3, b4)
+}
+
+func intExpMod(f1, f2, f3, f4 *Int) *Int {
+	return f1.ExpMod(f2, f3, f4)
+}
+
 func bigintMulDiv(b1, b2, b3, b4 *big.Int) *big.Int {
 	b1.Mul(b2, b3)
 	return b1.Div(b1, b4)
@@ -302,6 +310,9 @@ func fuzzTernaryOp(data []byte) int {
 	{ // mulDiv
 		checkThreeArgOp(intMulDiv, bigintMulDiv, x, y, z)
 	}
+	{ // expMod
+		checkThreeArgOp(intExpMod, bigintExpMod, x, y, z)
+	}
 	return 1
 }
 
@@ -0,0 +1,340 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/bits"
+)
+
+// ModInverse sets z to the multiplicative inverse of x in the ring ℤ/mℤ,
+// and returns z and true. If x and m are not relatively prime, x has no
+// multiplicative inverse; in this case z is unmodified and false is returned.
+// If m == 0, z is unmodified and false is returned (OBS: differs from the
+// big.Int, which panics).
+func (z *Int) ModInverse(x, m *Int) (*Int, bool) {
+	if m.IsZero() {
+		return z, false
+	}
+	if m.IsUint64() && m[0] == 1 {
+		// Everything is congruent to 0 mod 1, including x * 0 and 1.
+		return z.Clear(), true
+	}
+	var a Int
+	a.Mod(x, m)
+	if m[0]&1 == 1 {
+		inv, ok := modInverseOdd(&a, m)
+		if !ok {
+			return z, false
+		}
+		return z.Set(&inv), true
+	}
+	// For even m, an inverse can only exist for odd a. The binary algorithm
+	// needs an odd modulus, so the roles are swapped: with y = m⁻¹ mod a,
+	//
+	//	1 + m * (a - y) ≡ 1 - m * m⁻¹ ≡ 0 (mod a)
+	//
+	// and the quotient (1 + m * (a - y)) / a is the inverse of a mod m.
+	if a[0]&1 == 0 {
+		return z, false
+	}
+	if a.IsUint64() && a[0] == 1 {
+		return z.SetOne(), true
+	}
+	var b Int
+	b.Mod(m, &a)
+	y, ok := modInverseOdd(&b, &a)
+	if !ok {
+		return z, false
+	}
+	// The product is less than m * a < 2**512 - 1, so adding 1 cannot overflow.
+	p := umul(m, y.Sub(&a, &y))
+	var carry uint64
+	p[0], carry = bits.Add64(p[0], 1, 0)
+	for i := 1; i < len(p) && carry != 0; i++ {
+		p[i], carry = bits.Add64(p[i], 0, carry)
+	}
+	var quot [8]uint64
+	udivrem(quot[:], p[:], &a)
+	copy(z[:], quot[:4])
+	return z, true
+}
+
+// modInverseOdd computes the inverse of y modulo the odd m > 1, for y < m.
+// The return value reports whether the inverse exists, i.e. whether
+// gcd(y, m) == 1.
+func modInverseOdd(y, m *Int) (Int, bool) {
+	g, v := gcdOdd(y, m, true)
+	if !g.IsUint64() || g[0] != 1 {
+		return Int{}, false
+	}
+	return v, true
+}
+
+// gcdOdd returns g = gcd(y, m) for an odd m. If ext is set, it also returns
+// v < m such that v * y ≡ g (mod m); otherwise v is 0.
+//
+// This is the optimized binary GCD of T. Pornin, "Optimized Binary GCD for
+// Modular Inversion" (https://eprint.iacr.org/2020/972), in its variable-time
+// form: the steps of the binary GCD are run in batches of k-1 on 2k-bit
+// approximations of a and b, which only requires single words, and the
+// resulting transformation is then applied to the full values.
+func gcdOdd(y, m *Int, ext bool) (g, v Int) {
+	const k = 31
+	if m.IsUint64() && m[0] == 1 {
+		return Int{1}, Int{}
+	}
+	// Invariants: u * y ≡ a (mod m) and v * y ≡ b (mod m), with b odd.
+	var (
+		a, b = *y, *m
+		u    = Int{1}
+		mInv = -inverse64(m[0])
+	)
+	for !a.IsZero() {
+		// The approximations consist of the low k-1 bits and the
+		// top k+1 bits of a and b, at the position of the larger one.
+		n := a.BitLen()
+		if l := b.BitLen(); l > n {
+			n = l
+		}
+		if n < 2*k {
+			n = 2 * k
+		}
+		aa := a[0]&(1<<(k-1)-1) | a.bitsAt(uint(n-k-1))<<(k-1)
+		bb := b[0]&(1<<(k-1)-1) | b.bitsAt(uint(n-k-1))<<(k-1)
+
+		// Run k-1 steps of the binary GCD on the approximations, recording
+		// the transformation in the matrix [f0 g0; f1 g1], such that
+		// aa ≈ (f0 * a + g0 * b) / 2**i and bb ≈ (f1 * a + g1 * b) / 2**i.
+		f0, g0, f1, g1 := int64(1), int64(0), int64(0), int64(1)
+		for i := 0; i < k-1; i++ {
+			if aa&1 == 1 {
+				if aa < bb {
+					aa, bb = bb, aa
+					f0, g0, f1, g1 = f1, g1, f0, g0
+				}
+				aa -= bb
+				f0 -= f1
+				g0 -= g1
+			}
+			aa >>= 1
+			f1 <<= 1
+			g1 <<= 1
+		}
+
+		na, negA := linCombShift(&a, f0, &b, g0, k-1)
+		nb, negB := linCombShift(&a, f1, &b, g1, k-1)
+		a, b = na, nb
+		if !ext {
+			continue
+		}
+		if negA {
+			f0, g0 = -f0, -g0
+		}
+		if negB {
+			f1, g1 = -f1, -g1
+		}
+		u, v = linCombMod(&u, f0, &v, g0, m, mInv, k-1), linCombMod(&u, f1, &v, g1, m, mInv, k-1)
+	}
+	return b, v
+}
+
+// GCD sets z to the greatest common divisor of x and y, and returns z.
+// GCD(x, 0) = GCD(0, x) = x, and GCD(0, 0) = 0.
+func (z *Int) GCD(x, y *Int) *Int {
+	if x.IsZero() {
+		return z.Set(y)
+	}
+	if y.IsZero() {
+		return z.Set(x)
+	}
+	// gcd(x, y) = 2**k * gcd(x', y'), where x' and y' are x and y with all
+	// their trailing zeros removed, and k the smaller number of those.
+	tx, ty := trailingZeros(x), trailingZeros(y)
+	var xo, yo Int
+	xo.Rsh(x, tx)
+	yo.Rsh(y, ty)
+	if ty > tx {
+		ty = tx
+	}
+	g, _ := gcdOdd(&xo, &yo, false)
+	return z.Lsh(&g, ty)
+}
+
+// ExtGCD sets z to the greatest common divisor of x and y, and a and b to
+// Bézout coefficients such that
+//
+//	(±a) * x + (±b) * y = z
+//
+// where the signs are given by aNeg and bNeg. It returns z, a, b, aNeg and
+// bNeg. For non-zero x and y, the coefficients are bounded by |a| <= y / z
+// and |b| <= x / z. If x == 0, then z = y, a = 0 and b = 1, and likewise for
+// y == 0. If both are zero, z, a and b are set to 0.
+func (z *Int) ExtGCD(x, y, a, b *Int) (*Int, *Int, *Int, bool, bool) {
+	switch {
+	case x.IsZero() && y.IsZero():
+		return z.Clear(), a.Clear(), b.Clear(), false, false
+	case x.IsZero():
+		z.Set(y)
+		return z, a.Clear(), b.SetOne(), false, false
+	case y.IsZero():
+		z.Set(x)
+		return z, a.SetOne(), b.Clear(), false, false
+	}
+	// Coefficients for x / 2**k and y / 2**k also work for x and y.
+	k := trailingZeros(x)
+	if ty := trailingZeros(y); ty < k {
+		k = ty
+	}
+	var xs, ys Int
+	xs.Rsh(x, k)
+	ys.Rsh(y, k)
+	var g, ca, cb Int
+	if ys[0]&1 == 1 {
+		g, ca, cb = extGCDOdd(&xs, &ys)
+		z.Lsh(&g, k)
+		return z, a.Set(&ca), b.Set(&cb), false, !cb.IsZero() && !ca.IsZero()
+	}
+	g, cb, ca = extGCDOdd(&ys, &xs)
+	z.Lsh(&g, k)
+	return z, a.Set(&ca), b.Set(&cb), !ca.IsZero() && !cb.IsZero(), false
+}
+
+// extGCDOdd returns g = gcd(x, y) for x > 0 and an odd y, together with
+// a < y / g and b < x / g such that a * x - b * y = g, or a = 0 and b = 1
+// if y divides x.
+func extGCDOdd(x, y *Int) (g, a, b Int) {
+	var xr Int
+	g, a = gcdOdd(xr.Mod(x, y), y, true)
+	// a is unique modulo y / g; pick the smallest one.
+	var yg Int
+	if a.Mod(&a, yg.Div(y, &g)).IsZero() {
+		return g, a, Int{1}
+	}
+	// b = (a * x - g) / y, where a * x >= x >= g.
+	p := umul(&a, x)
+	var borrow uint64
+	p[0], borrow = bits.Sub64(p[0], g[0], 0)
+	p[1], borrow = bits.Sub64(p[1], g[1], borrow)
+	p[2], borrow = bits.Sub64(p[2], g[2], borrow)
+	p[3], borrow = bits.Sub64(p[3], g[3], borrow)
+	for i := 4; i < len(p) && borrow != 0; i++ {
+		p[i], borrow = bits.Sub64(p[i], 0, borrow)
+	}
+	var quot [8]uint64
+	udivrem(quot[:], p[:], y)
+	copy(b[:], quot[:4])
+	return g, a, b
+}
+
+// LCM sets z to the least common multiple of x and y, and returns z and
+// whether overflow occurred, i.e. the result does not fit in 256 bits.
+// If x == 0 or y == 0, z is set to 0.
+func (z *Int) LCM(x, y *Int) (*Int, bool) {
+	if x.IsZero() || y.IsZero() {
+		return z.Clear(), false
+	}
+	var g, q Int
+	g.GCD(x, y)
+	q.Div(x, &g)
+	return z.MulOverflow(&q, y)
+}
+
+// trailingZeros returns the number of trailing zero bits of x; 256 for x == 0.
+func trailingZeros(x *Int) uint {
+	for i, w := range x {
+		if w != 0 {
+			return uint(i*64 + bits.TrailingZeros64(w))
+		}
+	}
+	return 256
+}
+
+// bitsAt returns the 64 bits of z starting at bit position s.
+func (z *Int) bitsAt(s uint) uint64 {
+	w, off := s/64, s%64
+	r := z[w] >> off
+	if off != 0 && w < 3 {
+		r |= z[w+1] << (64 - off)
+	}
+	return r
+}
+
+// inverse64 returns the inverse of the odd x modulo 2**64.
+func inverse64(x uint64) uint64 {
+	// x * x ≡ 1 (mod 8), and each Newton step doubles the correct bits.
+	inv := x
+	for i := 0; i < 5; i++ {
+		inv *= 2 - x*inv
+	}
+	return inv
+}
+
+// linComb returns x * f + y * g as a 320-bit two's complement number.
+func linComb(x *Int, f int64, y *Int, g int64) (r0, r1, r2, r3, r4 uint64) {
+	var c, borrow uint64
+	c, r0 = bits.Mul64(x[0], uint64(f))
+	c, r1 = umulHop(c, x[1], uint64(f))
+	c, r2 = umulHop(c, x[2], uint64(f))
+	r4, r3 = umulHop(c, x[3], uint64(f))
+	c, r0 = umulHop(r0, y[0], uint64(g))
+	c, r1 = umulStep(r1, y[1], uint64(g), c)
+	c, r2 = umulStep(r2, y[2], uint64(g), c)
+	c, r3 = umulStep(r3, y[3], uint64(g), c)
+	r4 += c
+	// The words were multiplied as unsigned, i.e. by f + 2**64 if f < 0,
+	// so x * 2**64 (and likewise y * 2**64) must be subtracted.
+	mf, mg := uint64(f>>63), uint64(g>>63)
+	r1, borrow = bits.Sub64(r1, x[0]&mf, 0)
+	r2, borrow = bits.Sub64(r2, x[1]&mf, borrow)
+	r3, borrow = bits.Sub64(r3, x[2]&mf, borrow)
+	r4 -= x[3]&mf + borrow
+	r1, borrow = bits.Sub64(r1, y[0]&mg, 0)
+	r2, borrow = bits.Sub64(r2, y[1]&mg, borrow)
+	r3, borrow = bits.Sub64(r3, y[2]&mg, borrow)
+	r4 -= y[3]&mg + borrow
+	return r0, r1, r2, r3, r4
+}
+
+// linCombShift returns |x * f + y * g| >> s, and whether x * f + y * g is
+// negative. The sum must be divisible by 2**s, and the result fit in 256 bits.
+func linCombShift(x *Int, f int64, y *Int, g int64, s uint) (z Int, neg bool) {
+	r0, r1, r2, r3, r4 := linComb(x, f, y, g)
+	z[0] = r0>>s | r1<<(64-s)
+	z[1] = r1>>s | r2<<(64-s)
+	z[2] = r2>>s | r3<<(64-s)
+	z[3] = r3>>s | r4<<(64-s)
+	if int64(r4) < 0 {
+		z.Neg(&z)
+		return z, true
+	}
+	return z, false
+}
+
+// linCombMod returns (x * f + y * g) / 2**s mod m, for x, y < m, an odd m and
+// mInv = -m⁻¹ mod 2**64. It requires |f| + |g| <= 2**s.
+func linCombMod(x *Int, f int64, y *Int, g int64, m *Int, mInv uint64, s uint) (z Int) {
+	r0, r1, r2, r3, r4 := linComb(x, f, y, g)
+	// Add a multiple of m to clear the low s bits, then divide. The
+	// result is within (-m, 2m).
+	t := (r0 * mInv) & (1<<s - 1)
+	var c uint64
+	c, r0 = umulHop(r0, m[0], t)
+	c, r1 = umulStep(r1, m[1], t, c)
+	c, r2 = umulStep(r2, m[2], t, c)
+	c, r3 = umulStep(r3, m[3], t, c)
+	r4 += c
+	z[0] = r0>>s | r1<<(64-s)
+	z[1] = r1>>s | r2<<(64-s)
+	z[2] = r2>>s | r3<<(64-s)
+	z[3] = r3>>s | r4<<(64-s)
+	r4 = uint64(int64(r4) >> s)
+	switch {
+	case int64(r4) < 0:
+		z.Add(&z, m)
+	case r4 != 0 || !z.Lt(m):
+		z.Sub(&z, m)
+	}
+	return z
+}
@@ -0,0 +1,170 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testModInverse checks ModInverse against (*big.Int).ModInverse.
+func testModInverse(t *testing.T, x, m *Int) {
+	t.Helper()
+	z := NewInt(1337)
+	res, ok := z.ModInverse(x, m)
+	if res != z {
+		t.Fatalf("unexpected pointer returned: %p, expected: %p", res, z)
+	}
+	var want *big.Int
+	if !m.IsZero() {
+		want = new(big.Int).ModInverse(x.ToBig(), m.ToBig())
+	}
+	if want == nil {
+		if ok || !z.Eq(NewInt(1337)) {
+			t.Fatalf("ModInverse(%#x, %#x): have %#x, %v; expected no inverse and z unmodified", x, m, z, ok)
+		}
+		return
+	}
+	if !ok || !checkEq(want, z) {
+		t.Fatalf("ModInverse(%#x, %#x): have %#x, %v want %#x", x, m, z, ok, want)
+	}
+	// Check aliasing of the operands.
+	y := x.Clone()
+	if y.ModInverse(y, m); !y.Eq(z) {
+		t.Fatalf("ModInverse(%#x, %#x) with z == x: have %#x want %#x", x, m, y, z)
+	}
+	y = m.Clone()
+	if y.ModInverse(x, y); !y.Eq(z) {
+		t.Fatalf("ModInverse(%#x, %#x) with z == m: have %#x want %#x", x, m, y, z)
+	}
+}
+
+func TestRandomModInverse(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		_, f1, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, f2, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testModInverse(t, f1, f2)
+		// Odd moduli always have inverses for most values, even moduli
+		// only for odd values.
+		testModInverse(t, f1, new(Int).Or(f2, NewInt(1)))
+		testModInverse(t, new(Int).Or(f1, NewInt(1)), f2)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	mustHex := func(hex string) *Int {
+		z, err := FromHex(hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return z
+	}
+	values := []*Int{
+		NewInt(0), NewInt(1), NewInt(2), NewInt(3), NewInt(4), NewInt(6), NewInt(7),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+		new(Int).Lsh(NewInt(1), 64),
+		mustHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"), // secp256k1 p
+		mustHex("0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"), // bn254 p
+		mustHex("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe"),
+		mustHex("0x8000000000000000000000000000000000000000000000000000000000000001"),
+	}
+	for _, x := range values {
+		for _, m := range values {
+			testModInverse(t, x, m)
+		}
+	}
+}
+
+// testGCD checks GCD, ExtGCD and LCM against big.Int.
+func testGCD(t *testing.T, x, y *Int) {
+	t.Helper()
+	bx, by := x.ToBig(), y.ToBig()
+	want := new(big.Int).GCD(nil, nil, bx, by)
+	if z := new(Int).GCD(x, y); !checkEq(want, z) {
+		t.Fatalf("GCD(%#x, %#x): have %#x want %#x", x, y, z, want)
+	}
+	if z := x.Clone(); !checkEq(want, z.GCD(z, y)) {
+		t.Fatalf("GCD(%#x, %#x) with z == x: have %#x want %#x", x, y, z, want)
+	}
+
+	// ExtGCD, with all outputs aliasing the inputs.
+	z, a, b := x.Clone(), y.Clone(), new(Int)
+	_, _, _, aNeg, bNeg := z.ExtGCD(z, a, a, b)
+	if !checkEq(want, z) {
+		t.Fatalf("ExtGCD(%#x, %#x): have gcd %#x want %#x", x, y, z, want)
+	}
+	ba, bb := a.ToBig(), b.ToBig()
+	if aNeg {
+		ba.Neg(ba)
+	}
+	if bNeg {
+		bb.Neg(bb)
+	}
+	if sum := new(big.Int).Add(new(big.Int).Mul(ba, bx), new(big.Int).Mul(bb, by)); sum.Cmp(want) != 0 {
+		t.Fatalf("ExtGCD(%#x, %#x): %v * x + %v * y = %v, want %v", x, y, ba, bb, sum, want)
+	}
+	if !x.IsZero() && !y.IsZero() {
+		if a.Gt(new(Int).Div(y, z)) || b.Gt(new(Int).Div(x, z)) {
+			t.Fatalf("ExtGCD(%#x, %#x): coefficients %v, %v out of bounds", x, y, ba, bb)
+		}
+	}
+
+	// LCM
+	wantLCM := new(big.Int)
+	if want.Sign() != 0 {
+		wantLCM.Mul(bx, by).Div(wantLCM, want)
+	}
+	lcm, overflow := new(Int).LCM(x, y)
+	if wantOverflow := wantLCM.BitLen() > 256; overflow != wantOverflow {
+		t.Fatalf("LCM(%#x, %#x): have overflow %v want %v", x, y, overflow, wantOverflow)
+	}
+	if !checkEq(u256(wantLCM), lcm) {
+		t.Fatalf("LCM(%#x, %#x): have %#x want %#x", x, y, lcm, wantLCM)
+	}
+}
+
+func TestRandomGCD(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		_, f1, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, f2, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, f3, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		testGCD(t, f1, f2)
+		// Make sure to hit larger common divisors too.
+		testGCD(t, new(Int).Mul(f1, f3), new(Int).Mul(f2, f3))
+	}
+}
+
+func TestGCD(t *testing.T) {
+	values := []*Int{
+		NewInt(0), NewInt(1), NewInt(2), NewInt(3), NewInt(6), NewInt(12), NewInt(18),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+		new(Int).Lsh(NewInt(3), 200),
+		new(Int).Lsh(NewInt(1), 64),
+		new(Int).Lsh(NewInt(5), 64),
+		&Int{0, 0, 0, 0x8000000000000001},
+	}
+	for _, x := range values {
+		for _, y := range values {
+			testGCD(t, x, y)
+		}
+	}
+}
@@ -0,0 +1,480 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Int256 is a signed 256-bit integer in two's complement representation.
+// It has the same memory layout as Int: 4 uint64 limbs in little-endian
+// order, so that Int256[3] is the most significant, and its top bit is
+// the sign bit.
+//
+// All arithmetic wraps around modulo 2**256, as in the EVM and in
+// solidity's unchecked int256 arithmetic.
+type Int256 [4]uint64
+
+// ErrInt256Range is returned when a value does not fit in an Int256,
+// i.e. is not within [-2**255, 2**255-1].
+var ErrInt256Range = errors.New("number out of int256 range")
+
+// NewInt256 returns a new Int256 set to the value val.
+func NewInt256(val int64) *Int256 {
+	return new(Int256).SetInt64(val)
+}
+
+// Signed returns z reinterpreted as a two's complement signed integer.
+// No copy is made: the result shares its storage with z.
+func (z *Int) Signed() *Int256 {
+	return (*Int256)(z)
+}
+
+// Unsigned returns z reinterpreted as an unsigned integer, i.e. modulo 2**256.
+// No copy is made: the result shares its storage with z.
+func (z *Int256) Unsigned() *Int {
+	return (*Int)(z)
+}
+
+// SetInt64 sets z to the value x and returns z.
+func (z *Int256) SetInt64(x int64) *Int256 {
+	s := uint64(x >> 63) // sign extension
+	z[3], z[2], z[1], z[0] = s, s, s, uint64(x)
+	return z
+}
+
+// Int64 returns the lower 64 bits of z, interpreted as an int64.
+// If z does not fit in an int64, the result is undefined.
+func (z *Int256) Int64() int64 {
+	return int64(z[0])
+}
+
+// IsInt64 reports whether z can be represented as an int64.
+func (z *Int256) IsInt64() bool {
+	s := uint64(int64(z[0]) >> 63)
+	return z[1] == s && z[2] == s && z[3] == s
+}
+
+// Set sets z to x and returns z.
+func (z *Int256) Set(x *Int256) *Int256 {
+	*z = *x
+	return z
+}
+
+// Clone creates a new Int256 identical to z.
+func (z *Int256) Clone() *Int256 {
+	return &Int256{z[0], z[1], z[2], z[3]}
+}
+
+// Clear sets z to 0 and returns z.
+func (z *Int256) Clear() *Int256 {
+	z[3], z[2], z[1], z[0] = 0, 0, 0, 0
+	return z
+}
+
+// IsZero returns true if z == 0.
+func (z *Int256) IsZero() bool {
+	return (z[0] | z[1] | z[2] | z[3]) == 0
+}
+
+// Sign returns:
+//
+//	-1 if z <  0
+//	 0 if z == 0
+//	+1 if z >  0
+func (z *Int256) Sign() int {
+	return z.Unsigned().Sign()
+}
+
+// Cmp compares z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Int256) Cmp(x *Int256) int {
+	// Flipping the sign bits maps the signed order onto the unsigned one.
+	a := Int{z[0], z[1], z[2], z[3] ^ 0x8000000000000000}
+	b := Int{x[0], x[1], x[2], x[3] ^ 0x8000000000000000}
+	return a.Cmp(&b)
+}
+
+// Eq returns true if z == x.
+func (z *Int256) Eq(x *Int256) bool {
+	return z.Unsigned().Eq(x.Unsigned())
+}
+
+// Lt returns true if z < x.
+func (z *Int256) Lt(x *Int256) bool {
+	return z.Unsigned().Slt(x.Unsigned())
+}
+
+// Gt returns true if z > x.
+func (z *Int256) Gt(x *Int256) bool {
+	return z.Unsigned().Sgt(x.Unsigned())
+}
+
+// Add sets z to the sum x+y and returns z.
+func (z *Int256) Add(x, y *Int256) *Int256 {
+	z.Unsigned().Add(x.Unsigned(), y.Unsigned())
+	return z
+}
+
+// Sub sets z to the difference x-y and returns z.
+func (z *Int256) Sub(x, y *Int256) *Int256 {
+	z.Unsigned().Sub(x.Unsigned(), y.Unsigned())
+	return z
+}
+
+// Mul sets z to the product x*y and returns z.
+func (z *Int256) Mul(x, y *Int256) *Int256 {
+	z.Unsigned().Mul(x.Unsigned(), y.Unsigned())
+	return z
+}
+
+// Neg sets z to -x and returns z.
+// OBS! Neg(-2**255) = -2**255, since 2**255 is not representable.
+func (z *Int256) Neg(x *Int256) *Int256 {
+	z.Unsigned().Neg(x.Unsigned())
+	return z
+}
+
+// Abs sets z to |x| and returns z.
+// OBS! Abs(-2**255) = -2**255, since 2**255 is not representable.
+func (z *Int256) Abs(x *Int256) *Int256 {
+	z.Unsigned().Abs(x.Unsigned())
+	return z
+}
+
+// Quo sets z to the quotient x/y for y != 0 and returns z.
+// Quo implements truncated division (like Go); see QuoRem for more details.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Quo(x, y *Int256) *Int256 {
+	z.Unsigned().SDiv(x.Unsigned(), y.Unsigned())
+	return z
+}
+
+// Rem sets z to the remainder x%y for y != 0 and returns z.
+// Rem implements truncated modulus (like Go); see QuoRem for more details.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Rem(x, y *Int256) *Int256 {
+	z.Unsigned().SMod(x.Unsigned(), y.Unsigned())
+	return z
+}
+
+// QuoRem sets z to the quotient x/y and r to the remainder x%y
+// and returns the pair (z, r) for y != 0.
+// QuoRem implements T-division and modulus (like Go):
+//
+//	q = x/y      with the result truncated to zero
+//	r = x - y*q
+//
+// The overflowing case -2**255 / -1 yields q = -2**255 and r = 0.
+// If y == 0, both z and r are set to 0 (OBS: differs from the big.Int)
+func (z *Int256) QuoRem(x, y, r *Int256) (*Int256, *Int256) {
+	var q, m Int256
+	q.Quo(x, y)
+	m.Rem(x, y)
+	return z.Set(&q), r.Set(&m)
+}
+
+// Div sets z to the quotient x/y for y != 0 and returns z.
+// Div implements Euclidean division (unlike Go); see DivMod for more details.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Div(x, y *Int256) *Int256 {
+	var r Int256
+	z.DivMod(x, y, &r)
+	return z
+}
+
+// Mod sets z to the modulus x%y for y != 0 and returns z.
+// Mod implements Euclidean modulus (unlike Go); see DivMod for more details.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int256) Mod(x, y *Int256) *Int256 {
+	var q Int256
+	_, m := q.DivMod(x, y, z)
+	return m
+}
+
+// DivMod sets z to the quotient x div y and m to the modulus x mod y
+// and returns the pair (z, m) for y != 0.
+// DivMod implements Euclidean division and modulus (unlike Go):
+//
+//	q = x div y  such that
+//	m = x - y*q  with 0 <= m < |y|
+//
+// The overflowing case -2**255 div -1 yields q = -2**255 and m = 0.
+// If y == 0, both z and m are set to 0 (OBS: differs from the big.Int)
+func (z *Int256) DivMod(x, y, m *Int256) (*Int256, *Int256) {
+	var q, r Int256
+	q.Quo(x, y)
+	r.Rem(x, y)
+	if r.Sign() < 0 {
+		// Neither adjustment can overflow: |r| < |y|, and a non-zero
+		// remainder implies |y| > 1 and thus |q| < 2**254.
+		if y.Sign() > 0 {
+			r.Add(&r, y)
+			q.Sub(&q, &Int256{1})
+		} else {
+			r.Sub(&r, y)
+			q.Add(&q, &Int256{1})
+		}
+	}
+	return z.Set(&q), m.Set(&r)
+}
+
+// setMagnitude sets z to abs, negated if neg is set. If the result is not
+// within the range of Int256, ErrInt256Range is returned and z is unmodified.
+func (z *Int256) setMagnitude(abs *Int, neg bool) error {
+	if abs[3] >= 0x8000000000000000 {
+		// Only -2**255 may have the top bit set.
+		if !neg || abs[3] != 0x8000000000000000 || (abs[0]|abs[1]|abs[2]) != 0 {
+			return ErrInt256Range
+		}
+	}
+	if neg {
+		z.Unsigned().Neg(abs)
+	} else {
+		z.Unsigned().Set(abs)
+	}
+	return nil
+}
+
+// cutMinus strips a leading '-' from s, and reports whether it was present.
+// An explicit sign following the '-' is rejected with strconv.ErrSyntax,
+// since it would otherwise be accepted by the unsigned parsers.
+func cutMinus(s string) (string, bool, error) {
+	if len(s) == 0 || s[0] != '-' {
+		return s, false, nil
+	}
+	s = s[1:]
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		return "", false, strconv.ErrSyntax
+	}
+	return s, true, nil
+}
+
+// SetFromHex sets z from the given string, interpreted as a hexadecimal
+// number with an optional leading '-', e.g. "-0x1f". The rules for the
+// remainder are those of (*Int).SetFromHex.
+// If the value is out of range, ErrInt256Range is returned.
+func (z *Int256) SetFromHex(hex string) error {
+	hex, neg, err := cutMinus(hex)
+	if err != nil {
+		return err
+	}
+	var abs Int
+	if err := abs.SetFromHex(hex); err != nil {
+		return err
+	}
+	return z.setMagnitude(&abs, neg)
+}
+
+// SetFromDecimal sets z from the given string, interpreted as a decimal
+// number with an optional leading '+' or '-', e.g. "-123". The rules for
+// the remainder are those of (*Int).SetFromDecimal, except that "-0" is
+// accepted.
+// If the value is out of range, ErrInt256Range is returned.
+func (z *Int256) SetFromDecimal(s string) error {
+	s, neg, err := cutMinus(s)
+	if err != nil {
+		return err
+	}
+	var abs Int
+	if err := abs.SetFromDecimal(s); err != nil {
+		return err
+	}
+	return z.setMagnitude(&abs, neg)
+}
+
+// SetString sets z to the value of s, interpreted in the given base, and
+// returns z and a boolean indicating success. The semantics are those of
+// (*big.Int).SetString, see (*Int).SetString for details; unlike Int,
+// a leading '-' is accepted.
+// If the operation fails, the value of z is unmodified and nil is returned.
+func (z *Int256) SetString(s string, base int) (*Int256, bool) {
+	s, neg, err := cutMinus(s)
+	if err != nil {
+		return nil, false
+	}
+	var abs Int
+	if _, ok := abs.SetString(s, base); !ok {
+		return nil, false
+	}
+	if err := z.setMagnitude(&abs, neg); err != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+// FromHex256 is a convenience-constructor to create an Int256 from
+// a hexadecimal string, with an optional leading '-'.
+func FromHex256(hex string) (*Int256, error) {
+	var z Int256
+	if err := z.SetFromHex(hex); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// FromDecimal256 is a convenience-constructor to create an Int256 from
+// a decimal (base 10) string, with an optional leading '+' or '-'.
+func FromDecimal256(decimal string) (*Int256, error) {
+	var z Int256
+	if err := z.SetFromDecimal(decimal); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
+
+// SetFromBig converts a big.Int to Int256 and sets the value to z.
+// The return value reports whether the value of b did not fit in an
+// Int256, in which case z is set to b modulo 2**256.
+func (z *Int256) SetFromBig(b *big.Int) bool {
+	overflow := z.Unsigned().SetFromBig(b)
+	// For in-range values, the sign of the result matches that of b.
+	return overflow || (z.Sign() < 0) != (b.Sign() < 0)
+}
+
+// FromBig256 is a convenience-constructor from big.Int.
+// Returns a new Int256 and whether overflow occurred.
+// OBS: If b is nil, this method returns nil, false
+func FromBig256(b *big.Int) (*Int256, bool) {
+	if b == nil {
+		return nil, false
+	}
+	z := &Int256{}
+	overflow := z.SetFromBig(b)
+	return z, overflow
+}
+
+// ToBig returns a big.Int version of z.
+// Return `nil` if z is nil
+func (z *Int256) ToBig() *big.Int {
+	if z == nil {
+		return nil
+	}
+	var abs Int
+	b := abs.Abs(z.Unsigned()).ToBig()
+	if z.Sign() < 0 {
+		b.Neg(b)
+	}
+	return b
+}
+
+// Dec returns the decimal representation of z, with a leading '-'
+// if z is negative.
+func (z *Int256) Dec() string {
+	var buf [79]byte // -2**255 has 77 decimal digits
+	return string(z.AppendDec(buf[:0]))
+}
+
+// AppendDec appends the decimal representation of z, as generated by
+// z.Dec(), to dst and returns the extended buffer.
+func (z *Int256) AppendDec(dst []byte) []byte {
+	return z.AppendText(dst, 10)
+}
+
+// Hex encodes z in 0x-prefixed hexadecimal form, with a leading '-'
+// if z is negative, e.g. "-0x1f".
+func (z *Int256) Hex() string {
+	var abs Int
+	if z.Sign() < 0 {
+		return "-" + abs.Abs(z.Unsigned()).Hex()
+	}
+	return z.Unsigned().Hex()
+}
+
+// Text returns the string representation of z in the given base, with
+// a leading '-' if z is negative. See (*Int).Text for details.
+func (z *Int256) Text(base int) string {
+	var buf [257]byte
+	return string(z.AppendText(buf[:0], base))
+}
+
+// AppendText appends the string representation of z, as generated by
+// z.Text(base), to dst and returns the extended buffer.
+func (z *Int256) AppendText(dst []byte, base int) []byte {
+	var abs Int
+	if z.Sign() < 0 {
+		dst = append(dst, '-')
+	}
+	return abs.Abs(z.Unsigned()).AppendText(dst, base)
+}
+
+// String returns the hex encoding of z.
+func (z *Int256) String() string {
+	return z.Hex()
+}
+
+// Format implements fmt.Formatter, with the same verbs and flags as
+// (*Int).Format. The output is identical to that of (*big.Int).Format.
+func (z *Int256) Format(s fmt.State, ch rune) {
+	if z == nil {
+		formatInt(s, ch, nil, false)
+		return
+	}
+	var abs Int
+	formatInt(s, ch, abs.Abs(z.Unsigned()), z.Sign() < 0)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (z *Int256) MarshalText() ([]byte, error) {
+	return []byte(z.Hex()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (z *Int256) UnmarshalText(input []byte) error {
+	return z.SetFromHex(string(input))
+}
+
+// MarshalJSON implements json.Marshaler.
+func (z *Int256) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + z.Hex() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (z *Int256) UnmarshalJSON(input []byte) error {
+	if len(input) < 2 || input[0] != '"' || input[len(input)-1] != '"' {
+		return ErrNonString
+	}
+	return z.UnmarshalText(input[1 : len(input)-1])
+}
+
+// Scan implements the database/sql Scanner interface.
+// It decodes a decimal string with an optional leading '-', also accepting
+// the exponent notation handled by (*Int).Scan.
+func (dst *Int256) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		dst.Clear()
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("cannot scan %T", src)
+	}
+	s, neg, err := cutMinus(s)
+	if err != nil {
+		return err
+	}
+	var abs Int
+	if err := abs.Scan(s); err != nil {
+		return err
+	}
+	return dst.setMagnitude(&abs, neg)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+// It encodes a base 10 string, see (*Int).Value.
+func (src *Int256) Value() (driver.Value, error) {
+	return src.Dec(), nil
+}
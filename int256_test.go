@@ -0,0 +1,350 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+var (
+	minInt256 = &Int256{0, 0, 0, 0x8000000000000000}
+	maxInt256 = &Int256{^uint64(0), ^uint64(0), ^uint64(0), 0x7fffffffffffffff}
+
+	errAny = fmt.Errorf("any error") // matches any non-nil error in test tables
+)
+
+// int256TestValues returns the edge cases around 0 and the range limits,
+// followed by random values.
+func int256TestValues(t *testing.T) []*Int256 {
+	t.Helper()
+	values := []*Int256{
+		NewInt256(0), NewInt256(1), NewInt256(-1), NewInt256(2), NewInt256(-2),
+		NewInt256(7), NewInt256(-7),
+		minInt256, new(Int256).Add(minInt256, NewInt256(1)),
+		maxInt256, new(Int256).Sub(maxInt256, NewInt256(1)),
+		(*Int256)(&Int{0, 1, 0, 0}), (*Int256)(&Int{0, 0, 0, ^uint64(0)}),
+	}
+	for i := 0; i < 16; i++ {
+		_, f, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, f.Signed())
+	}
+	return values
+}
+
+// wrapInt256 reduces b into the range of Int256, by wrapping modulo 2**256.
+func wrapInt256(b *big.Int) *big.Int {
+	var z Int256
+	z.SetFromBig(b)
+	return z.ToBig()
+}
+
+func TestInt256BinOp(t *testing.T) {
+	type opFunc func(z, x, y *Int256) *Int256
+	type bigFunc func(z, x, y *big.Int) *big.Int
+	ops := []struct {
+		name   string
+		op     opFunc
+		bigOp  bigFunc
+		divide bool
+	}{
+		{"Add", (*Int256).Add, (*big.Int).Add, false},
+		{"Sub", (*Int256).Sub, (*big.Int).Sub, false},
+		{"Mul", (*Int256).Mul, (*big.Int).Mul, false},
+		{"Quo", (*Int256).Quo, (*big.Int).Quo, true},
+		{"Rem", (*Int256).Rem, (*big.Int).Rem, true},
+		{"Div", (*Int256).Div, (*big.Int).Div, true},
+		{"Mod", (*Int256).Mod, (*big.Int).Mod, true},
+	}
+	values := int256TestValues(t)
+	for _, tc := range ops {
+		for _, x := range values {
+			for _, y := range values {
+				var want *big.Int
+				if tc.divide && y.IsZero() {
+					want = new(big.Int)
+				} else {
+					want = wrapInt256(tc.bigOp(new(big.Int), x.ToBig(), y.ToBig()))
+				}
+				// Check result and operand aliasing.
+				have := tc.op(new(Int256), x, y)
+				if have.ToBig().Cmp(want) != 0 {
+					t.Fatalf("%s(%v, %v): have %v want %v", tc.name, x.ToBig(), y.ToBig(), have.ToBig(), want)
+				}
+				if z := x.Clone(); tc.op(z, z, y).ToBig().Cmp(want) != 0 {
+					t.Fatalf("%s(%v, %v) with z == x: have %v want %v", tc.name, x.ToBig(), y.ToBig(), z.ToBig(), want)
+				}
+				if z := y.Clone(); tc.op(z, x, z).ToBig().Cmp(want) != 0 {
+					t.Fatalf("%s(%v, %v) with z == y: have %v want %v", tc.name, x.ToBig(), y.ToBig(), z.ToBig(), want)
+				}
+			}
+		}
+	}
+}
+
+func TestInt256DivMod(t *testing.T) {
+	values := int256TestValues(t)
+	for _, x := range values {
+		for _, y := range values {
+			if y.IsZero() {
+				q, r := NewInt256(5).QuoRem(x, y, NewInt256(5))
+				d, m := NewInt256(5).DivMod(x, y, NewInt256(5))
+				if !q.IsZero() || !r.IsZero() || !d.IsZero() || !m.IsZero() {
+					t.Fatalf("division of %v by zero: have %v %v %v %v", x.ToBig(), q, r, d, m)
+				}
+				continue
+			}
+			wantQ, wantR := new(big.Int).QuoRem(x.ToBig(), y.ToBig(), new(big.Int))
+			wantD, wantM := new(big.Int).DivMod(x.ToBig(), y.ToBig(), new(big.Int))
+			q, r := new(Int256).QuoRem(x, y, new(Int256))
+			if q.ToBig().Cmp(wrapInt256(wantQ)) != 0 || r.ToBig().Cmp(wantR) != 0 {
+				t.Fatalf("QuoRem(%v, %v): have %v, %v want %v, %v", x.ToBig(), y.ToBig(), q.ToBig(), r.ToBig(), wantQ, wantR)
+			}
+			d, m := new(Int256).DivMod(x, y, new(Int256))
+			if d.ToBig().Cmp(wrapInt256(wantD)) != 0 || m.ToBig().Cmp(wantM) != 0 {
+				t.Fatalf("DivMod(%v, %v): have %v, %v want %v, %v", x.ToBig(), y.ToBig(), d.ToBig(), m.ToBig(), wantD, wantM)
+			}
+			// z and m aliasing the operands
+			z, m2 := x.Clone(), y.Clone()
+			z.DivMod(z, m2, m2)
+			if !z.Eq(d) || !m2.Eq(m) {
+				t.Fatalf("DivMod(%v, %v) with aliasing: have %v, %v want %v, %v", x.ToBig(), y.ToBig(), z.ToBig(), m2.ToBig(), d.ToBig(), m.ToBig())
+			}
+		}
+	}
+}
+
+func TestInt256UnOp(t *testing.T) {
+	for _, x := range int256TestValues(t) {
+		b := x.ToBig()
+		if have, want := new(Int256).Neg(x).ToBig(), wrapInt256(new(big.Int).Neg(b)); have.Cmp(want) != 0 {
+			t.Fatalf("Neg(%v): have %v want %v", b, have, want)
+		}
+		if have, want := new(Int256).Abs(x).ToBig(), wrapInt256(new(big.Int).Abs(b)); have.Cmp(want) != 0 {
+			t.Fatalf("Abs(%v): have %v want %v", b, have, want)
+		}
+		if have, want := x.Sign(), b.Sign(); have != want {
+			t.Fatalf("Sign(%v): have %v want %v", b, have, want)
+		}
+		if have, want := x.IsInt64(), b.IsInt64(); have != want {
+			t.Fatalf("IsInt64(%v): have %v want %v", b, have, want)
+		}
+		if x.IsInt64() && x.Int64() != b.Int64() {
+			t.Fatalf("Int64(%v): have %v want %v", b, x.Int64(), b.Int64())
+		}
+		if y := NewInt256(x.Int64()); x.IsInt64() && !y.Eq(x) {
+			t.Fatalf("SetInt64(%v): have %v", b, y.ToBig())
+		}
+	}
+}
+
+func TestInt256Cmp(t *testing.T) {
+	values := int256TestValues(t)
+	for _, x := range values {
+		for _, y := range values {
+			want := x.ToBig().Cmp(y.ToBig())
+			if have := x.Cmp(y); have != want {
+				t.Fatalf("Cmp(%v, %v): have %d want %d", x.ToBig(), y.ToBig(), have, want)
+			}
+			if x.Lt(y) != (want < 0) || x.Gt(y) != (want > 0) || x.Eq(y) != (want == 0) {
+				t.Fatalf("Lt/Gt/Eq(%v, %v) inconsistent with Cmp", x.ToBig(), y.ToBig())
+			}
+		}
+	}
+}
+
+func TestInt256Conversion(t *testing.T) {
+	u := NewInt(1)
+	s := u.Signed()
+	s.Neg(s)
+	if !u.Eq(new(Int).SetAllOne()) {
+		t.Fatalf("Signed does not alias: have %v", u)
+	}
+	if s.Unsigned() != u {
+		t.Fatalf("Unsigned(Signed(u)) != u")
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), 255)
+	for i, tc := range []struct {
+		b        *big.Int
+		overflow bool
+	}{
+		{big.NewInt(0), false},
+		{big.NewInt(-1), false},
+		{new(big.Int).Sub(limit, big.NewInt(1)), false},
+		{new(big.Int).Neg(limit), false},
+		{limit, true},
+		{new(big.Int).Sub(new(big.Int).Neg(limit), big.NewInt(1)), true},
+		{new(big.Int).Lsh(limit, 1), true},
+		{new(big.Int).Neg(new(big.Int).Lsh(limit, 2)), true},
+	} {
+		z, overflow := FromBig256(tc.b)
+		if overflow != tc.overflow {
+			t.Errorf("test %d: FromBig256(%v) overflow: have %v want %v", i, tc.b, overflow, tc.overflow)
+		}
+		if !overflow && z.ToBig().Cmp(tc.b) != 0 {
+			t.Errorf("test %d: FromBig256(%v): have %v", i, tc.b, z.ToBig())
+		}
+	}
+	if z, overflow := FromBig256(nil); z != nil || overflow {
+		t.Errorf("FromBig256(nil): have %v, %v", z, overflow)
+	}
+	if b := (*Int256)(nil).ToBig(); b != nil {
+		t.Errorf("nil.ToBig(): have %v", b)
+	}
+}
+
+func TestInt256Text(t *testing.T) {
+	for _, x := range int256TestValues(t) {
+		b := x.ToBig()
+		if have, want := x.Dec(), b.String(); have != want {
+			t.Fatalf("Dec: have %q want %q", have, want)
+		}
+		for _, base := range []int{2, 7, 10, 16, 62} {
+			if have, want := x.Text(base), b.Text(base); have != want {
+				t.Fatalf("Text(%d): have %q want %q", base, have, want)
+			}
+			if y, ok := new(Int256).SetString(b.Text(base), base); !ok || !y.Eq(x) {
+				t.Fatalf("SetString(%q, %d): have %v, %v", b.Text(base), base, y, ok)
+			}
+		}
+		for _, format := range []string{"%d", "%+d", "%x", "%#X", "%010d", "%-8v", "%.3o", "%s"} {
+			if have, want := fmt.Sprintf(format, x), fmt.Sprintf(format, b); have != want {
+				t.Fatalf("format %q: have %q want %q", format, have, want)
+			}
+		}
+		var want string
+		if b.Sign() < 0 {
+			want = "-0x" + new(big.Int).Neg(b).Text(16)
+		} else {
+			want = "0x" + b.Text(16)
+		}
+		if have := x.Hex(); have != want {
+			t.Fatalf("Hex: have %q want %q", have, want)
+		}
+		if have := x.String(); have != want {
+			t.Fatalf("String: have %q want %q", have, want)
+		}
+		if y, err := FromHex256(want); err != nil || !y.Eq(x) {
+			t.Fatalf("FromHex256(%q): have %v, %v", want, y, err)
+		}
+		if y, err := FromDecimal256(x.Dec()); err != nil || !y.Eq(x) {
+			t.Fatalf("FromDecimal256(%q): have %v, %v", x.Dec(), y, err)
+		}
+	}
+	if have, want := fmt.Sprintf("%d %q", (*Int256)(nil), NewInt256(-5)), fmt.Sprintf("%d %q", (*big.Int)(nil), big.NewInt(-5)); have != want {
+		t.Fatalf("format: have %q want %q", have, want)
+	}
+}
+
+func TestInt256Parse(t *testing.T) {
+	for i, tc := range []struct {
+		input string
+		hex   bool
+		want  *Int256
+		err   error
+	}{
+		{"-0x1f", true, NewInt256(-31), nil},
+		{"0x1f", true, NewInt256(31), nil},
+		{"-0x0", true, NewInt256(0), nil},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", true, maxInt256, nil},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000000", true, minInt256, nil},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", true, nil, ErrInt256Range},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000001", true, nil, ErrInt256Range},
+		{"--0x1", true, nil, errAny},
+		{"-+0x1", true, nil, errAny},
+		{"+0x1", true, nil, ErrMissingPrefix},
+		{"-", true, nil, ErrEmptyString},
+		{"-1f", true, nil, ErrMissingPrefix},
+		{"-123", false, NewInt256(-123), nil},
+		{"+123", false, NewInt256(123), nil},
+		{"-0", false, NewInt256(0), nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", false, maxInt256, nil},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", false, minInt256, nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", false, nil, ErrInt256Range},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", false, nil, ErrInt256Range},
+		{"-115792089237316195423570985008687907853269984665640564039457584007913129639936", false, nil, ErrBig256Range},
+		{"-+1", false, nil, errAny},
+		{"--1", false, nil, errAny},
+		{"-", false, nil, errAny},
+	} {
+		z := NewInt256(1337)
+		var err error
+		if tc.hex {
+			err = z.SetFromHex(tc.input)
+		} else {
+			err = z.SetFromDecimal(tc.input)
+		}
+		if tc.err == nil {
+			if err != nil || !z.Eq(tc.want) {
+				t.Errorf("test %d: %q: have %v, %v want %v", i, tc.input, z.ToBig(), err, tc.want.ToBig())
+			}
+			continue
+		}
+		if err == nil || (tc.err != errAny && err != tc.err) {
+			t.Errorf("test %d: %q: have err %v want %v", i, tc.input, err, tc.err)
+		}
+		if z.Int64() != 1337 {
+			t.Errorf("test %d: %q: failed call modified z", i, tc.input)
+		}
+	}
+}
+
+func TestInt256Marshal(t *testing.T) {
+	type jsonStruct struct {
+		Foo *Int256
+	}
+	for _, x := range int256TestValues(t) {
+		enc, err := json.Marshal(&jsonStruct{x})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf(`{"Foo":"%s"}`, x.Hex()); string(enc) != want {
+			t.Fatalf("json: have %s want %s", enc, want)
+		}
+		var dec jsonStruct
+		if err := json.Unmarshal(enc, &dec); err != nil {
+			t.Fatal(err)
+		}
+		if !dec.Foo.Eq(x) {
+			t.Fatalf("json round-trip: have %v want %v", dec.Foo.ToBig(), x.ToBig())
+		}
+
+		v, _ := x.Value()
+		var y Int256
+		if err := y.Scan(v); err != nil || !y.Eq(x) {
+			t.Fatalf("sql round-trip of %v: have %v, %v", x.ToBig(), y.ToBig(), err)
+		}
+		if err := y.Scan([]byte(v.(string))); err != nil || !y.Eq(x) {
+			t.Fatalf("sql round-trip of %v: have %v, %v", x.ToBig(), y.ToBig(), err)
+		}
+	}
+	if err := new(Int256).UnmarshalJSON([]byte("-5")); err != ErrNonString {
+		t.Fatalf("have %v want %v", err, ErrNonString)
+	}
+	var z Int256
+	for _, tc := range []struct {
+		src  interface{}
+		want *Int256
+	}{
+		{"-12e3", NewInt256(-12000)},
+		{"12e3", NewInt256(12000)},
+		{nil, NewInt256(0)},
+	} {
+		if err := z.Scan(tc.src); err != nil || !z.Eq(tc.want) {
+			t.Errorf("Scan(%v): have %v, %v want %v", tc.src, z.ToBig(), err, tc.want.ToBig())
+		}
+	}
+	for _, src := range []interface{}{"-6e76", "6e76", "--1", 5} {
+		if err := z.Scan(src); err == nil {
+			t.Errorf("Scan(%v): expected error", src)
+		}
+	}
+}
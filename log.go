@@ -0,0 +1,82 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Log2 returns floor(log2(z)), the index of the most significant set bit.
+// If z == 0, -1 is returned.
+func (z *Int) Log2() int {
+	return z.BitLen() - 1
+}
+
+// CeilLog2 returns ceil(log2(z)), the smallest n such that 2^n >= z.
+// If z == 0, -1 is returned.
+func (z *Int) CeilLog2() int {
+	if z.IsZero() {
+		return -1
+	}
+	var t Int
+	return t.SubUint64(z, 1).BitLen()
+}
+
+// Log10 returns floor(log10(z)).
+// If z == 0, -1 is returned.
+func (z *Int) Log10() int {
+	if z.IsZero() {
+		return -1
+	}
+	// 1233/4096 approximates log10(2) closely enough that this is exactly
+	// floor(log10(2^Log2(z))), which is either the result or one less.
+	e := (z.BitLen() - 1) * 1233 >> 12
+	if e+1 < len(tenPowers) && !z.Lt(&tenPowers[e+1]) {
+		e++
+	}
+	return e
+}
+
+// DecLen returns the number of decimal digits of z, i.e. the length of
+// z.Dec(). If z == 0, 1 is returned.
+func (z *Int) DecLen() int {
+	if z.IsZero() {
+		return 1
+	}
+	return z.Log10() + 1
+}
+
+// LogBase returns floor(log_b(z)).
+// If z == 0, -1 is returned. It panics if b < 2.
+func (z *Int) LogBase(b uint64) int {
+	if b < 2 {
+		panic("uint256: invalid base for LogBase")
+	}
+	if z.IsZero() {
+		return -1
+	}
+	if b&(b-1) == 0 {
+		return z.Log2() / bits.TrailingZeros64(b)
+	}
+	if b == 10 {
+		return z.Log10()
+	}
+	// The floating point estimate is off by at most one, so starting one
+	// below it, b^e never overflows and at most two steps are needed.
+	e := int(float64(z.BitLen()-1)/math.Log2(float64(b))) - 1
+	if e < 0 {
+		e = 0
+	}
+	var p, base Int
+	base.SetUint64(b)
+	p.Exp(&base, NewInt(uint64(e)))
+	for {
+		if _, overflow := p.MulOverflow(&p, &base); overflow || z.Lt(&p) {
+			return e
+		}
+		e++
+	}
+}
@@ -0,0 +1,89 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// bigLogBase returns floor(log_b(x)) for x > 0, by repeated multiplication.
+func bigLogBase(x *big.Int, b uint64) int {
+	var (
+		e    = 0
+		p    = big.NewInt(1)
+		base = new(big.Int).SetUint64(b)
+	)
+	for {
+		p.Mul(p, base)
+		if p.Cmp(x) > 0 {
+			return e
+		}
+		e++
+	}
+}
+
+// testLog checks all logarithms of x against big.Int.
+func testLog(t *testing.T, x *Int) {
+	t.Helper()
+	if x.IsZero() {
+		if x.Log2() != -1 || x.CeilLog2() != -1 || x.Log10() != -1 || x.LogBase(3) != -1 || x.DecLen() != 1 {
+			t.Fatalf("unexpected logarithms of zero")
+		}
+		return
+	}
+	b := x.ToBig()
+	if have, want := x.Log2(), b.BitLen()-1; have != want {
+		t.Fatalf("Log2(%v): have %d want %d", x, have, want)
+	}
+	wantCeil := new(big.Int).Sub(b, big.NewInt(1)).BitLen()
+	if have := x.CeilLog2(); have != wantCeil {
+		t.Fatalf("CeilLog2(%v): have %d want %d", x, have, wantCeil)
+	}
+	wantDecLen := len(b.String())
+	if have := x.Log10(); have != wantDecLen-1 {
+		t.Fatalf("Log10(%v): have %d want %d", x, have, wantDecLen-1)
+	}
+	if have := x.DecLen(); have != wantDecLen {
+		t.Fatalf("DecLen(%v): have %d want %d", x, have, wantDecLen)
+	}
+	for _, base := range logBases {
+		if have, want := x.LogBase(base), bigLogBase(b, base); have != want {
+			t.Fatalf("LogBase(%v, %d): have %d want %d", x, base, have, want)
+		}
+	}
+}
+
+var logBases = []uint64{2, 3, 5, 7, 8, 10, 16, 36, 62, 100, 255, 256, 1000, 1 << 32, 1<<32 + 15, 1e19, 1<<64 - 1}
+
+func TestLog(t *testing.T) {
+	testLog(t, new(Int))
+	testLog(t, new(Int).SetAllOne())
+	// Every power of every base, and its neighbours.
+	for _, base := range append(logBases, 11, 13) {
+		p := NewInt(1)
+		for {
+			testLog(t, p)
+			testLog(t, new(Int).AddUint64(p, 1))
+			testLog(t, new(Int).SubUint64(p, 1))
+			if _, overflow := p.MulOverflow(p, NewInt(base)); overflow {
+				break
+			}
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testLog(t, x)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("LogBase(1): expected panic")
+		}
+	}()
+	NewInt(7).LogBase(1)
+}
@@ -22,13 +22,14 @@ func leadingZeros(x *Int) (z int) {
 // Reciprocal computes a 320-bit value representing 1/m
 //
 // Notes:
-// - specialized for m[3] != 0, hence limited to 2^192 <= m < 2^256
-// - returns zero if m[3] == 0
+// - for 2^192 <= m < 2^256, mu = 2^512/m
+// - for a smaller m of k words, mu = 2^(128k)/m, see reciprocalSmall
+// - returns zero if m == 0
 // - starts with a 32-bit division, refines with newton-raphson iterations
 func Reciprocal(m *Int) (mu [5]uint64) {
 
 	if m[3] == 0 {
-		return mu
+		return reciprocalSmall(m)
 	}
 
 	s := bits.LeadingZeros64(m[3]) // Replace with leadingZeros(m) for general case
@@ -339,9 +340,343 @@ func Reciprocal(m *Int) (mu [5]uint64) {
 	return mu
 }
 
+// reciprocalSmall computes mu = 2^(128k)/m for 0 < m < 2^192, where k is the
+// number of words of m, in the low k+1 words of mu. As needed by reduce1,
+// reduce2 and reduce3, which reduce values below 2^(128k).
+//
+// Notes:
+// - for m = 2^(64(k-1)) the quotient does not fit, and is replaced by
+//   2^(64(k+1))-1, which the final subtractions of the reduction absorb
+// - returns zero if m == 0
+func reciprocalSmall(m *Int) (mu [5]uint64) {
+
+	k := (m.BitLen() + 63) / 64
+	if k == 0 {
+		return mu
+	}
+
+	var u, quot [7]uint64
+
+	u[2*k] = 1
+	udivrem(quot[:], u[:2*k+1], m)
+
+	if quot[k+1] != 0 {
+		for i := 0; i <= k; i++ {
+			mu[i] = ^uint64(0)
+		}
+		return mu
+	}
+
+	copy(mu[:k+1], quot[:k+1])
+
+	return mu
+}
+
+// reduceSmall computes the least non-negative residue of x modulo m, for a
+// modulus of k < 4 words and its reciprocal (mu)
+//
+// The reduce1, reduce2 and reduce3 kernels reduce 2k words at once, so the
+// top 2k words are reduced first, then each step appends (up to) k more words
+// to the residue and reduces the result.
+func reduceSmall(x *[8]uint64, m *Int, mu *[5]uint64, k int) (z Int) {
+
+	n := len(x)
+	for n > 2*k && x[n-1] == 0 {
+		n--
+	}
+	if n <= 2*k {
+		return reduceWords(x, m, mu, k)
+	}
+
+	var t [8]uint64
+
+	i := n - 2*k
+	copy(t[:], x[i:n])
+	z = reduceWords(&t, m, mu, k)
+
+	for i > 0 {
+		j := k
+		if i < k {
+			j = i
+		}
+		i -= j
+
+		t = [8]uint64{}
+		copy(t[:j], x[i:i+j])
+		copy(t[j:j+k], z[:k])
+		z = reduceWords(&t, m, mu, k)
+	}
+
+	return z
+}
+
+// reduceWords dispatches to the reduction kernel of a k-word modulus
+func reduceWords(x *[8]uint64, m *Int, mu *[5]uint64, k int) Int {
+	switch k {
+	case 1:
+		return reduce1(x, m, mu)
+	case 2:
+		return reduce2(x, m, mu)
+	}
+	return reduce3(x, m, mu)
+}
+
+// reduce1 computes the least non-negative residue of x modulo m
+//
+// requires a one-word modulus (m[0] != 0, m[1] == m[2] == m[3] == 0),
+// its reciprocal (mu) and x < 2^128
+func reduce1(x *[8]uint64, m *Int, mu *[5]uint64) (z Int) {
+
+	// NB: See reduce4 for the names, from the Handbook of Applied Cryptography.
+
+	// q1 = x
+
+	x0 := x[0]
+	x1 := x[1]
+
+	// q2 = q1 * mu; q3 = q2 / 2^128
+
+	var q0, q1, q2, q3, c uint64
+
+	c, q0 = bits.Mul64(x0, mu[0])
+	c, q1 = umulHop(c, x0, mu[1])
+	q2 = c
+
+	c, q1 = umulHop(q1, x1, mu[0])
+	c, q2 = umulStep(q2, x1, mu[1], c)
+	q3 = c
+
+	// Drop the fractional part of q3
+
+	q0 = q2
+	q1 = q3
+
+	// r2 = q3 * m mod 2^128
+
+	var r0, r1 uint64
+
+	c, r0 = bits.Mul64(q0, m[0])
+	r1 = c
+
+	r1 += q1 * m[0]
+
+	// r = r1 - r2, where r1 = x mod 2^128; as 0 <= r < 4m, the
+	// difference modulo 2^128 is exact
+
+	var b uint64
+
+	r0, b = bits.Sub64(x[0], r0, 0)
+	r1, _ = bits.Sub64(x[1], r1, b)
+
+	// while (r>=m) r-=m
+
+	for {
+		// q = r - m
+		q0, b = bits.Sub64(r0, m[0], 0)
+		q1, b = bits.Sub64(r1, 0, b)
+
+		// if borrow break
+		if b != 0 {
+			break
+		}
+
+		// r = q
+		r1, r0 = q1, q0
+	}
+
+	z[0] = r0
+
+	return z
+}
+
+// reduce2 computes the least non-negative residue of x modulo m
+//
+// requires a two-word modulus (m[1] != 0, m[2] == m[3] == 0),
+// its reciprocal (mu) and x < 2^256
+func reduce2(x *[8]uint64, m *Int, mu *[5]uint64) (z Int) {
+
+	// NB: See reduce4 for the names, from the Handbook of Applied Cryptography.
+
+	// q1 = x/2^64
+
+	x0 := x[1]
+	x1 := x[2]
+	x2 := x[3]
+
+	// q2 = q1 * mu; q3 = q2 / 2^192
+
+	var q0, q1, q2, q3, q4, q5, c uint64
+
+	c, q0 = bits.Mul64(x0, mu[0])
+	c, q1 = umulHop(c, x0, mu[1])
+	c, q2 = umulHop(c, x0, mu[2])
+	q3 = c
+
+	c, q1 = umulHop(q1, x1, mu[0])
+	c, q2 = umulStep(q2, x1, mu[1], c)
+	c, q3 = umulStep(q3, x1, mu[2], c)
+	q4 = c
+
+	c, q2 = umulHop(q2, x2, mu[0])
+	c, q3 = umulStep(q3, x2, mu[1], c)
+	c, q4 = umulStep(q4, x2, mu[2], c)
+	q5 = c
+
+	// Drop the fractional part of q3
+
+	q0 = q3
+	q1 = q4
+	q2 = q5
+
+	// r2 = q3 * m mod 2^192
+
+	var r0, r1, r2 uint64
+
+	c, r0 = bits.Mul64(q0, m[0])
+	c, r1 = umulHop(c, q0, m[1])
+	r2 = c
+
+	c, r1 = umulHop(r1, q1, m[0])
+	r2 += q1*m[1] + c
+
+	r2 += q2 * m[0]
+
+	// r = r1 - r2, where r1 = x mod 2^192; as 0 <= r < 4m, the
+	// difference modulo 2^192 is exact
+
+	var b uint64
+
+	r0, b = bits.Sub64(x[0], r0, 0)
+	r1, b = bits.Sub64(x[1], r1, b)
+	r2, _ = bits.Sub64(x[2], r2, b)
+
+	// while (r>=m) r-=m
+
+	for {
+		// q = r - m
+		q0, b = bits.Sub64(r0, m[0], 0)
+		q1, b = bits.Sub64(r1, m[1], b)
+		q2, b = bits.Sub64(r2, 0, b)
+
+		// if borrow break
+		if b != 0 {
+			break
+		}
+
+		// r = q
+		r2, r1, r0 = q2, q1, q0
+	}
+
+	z[1], z[0] = r1, r0
+
+	return z
+}
+
+// reduce3 computes the least non-negative residue of x modulo m
+//
+// requires a three-word modulus (m[2] != 0, m[3] == 0),
+// its reciprocal (mu) and x < 2^384
+func reduce3(x *[8]uint64, m *Int, mu *[5]uint64) (z Int) {
+
+	// NB: See reduce4 for the names, from the Handbook of Applied Cryptography.
+
+	// q1 = x/2^128
+
+	x0 := x[2]
+	x1 := x[3]
+	x2 := x[4]
+	x3 := x[5]
+
+	// q2 = q1 * mu; q3 = q2 / 2^256
+
+	var q0, q1, q2, q3, q4, q5, q6, q7, c uint64
+
+	c, q0 = bits.Mul64(x0, mu[0])
+	c, q1 = umulHop(c, x0, mu[1])
+	c, q2 = umulHop(c, x0, mu[2])
+	c, q3 = umulHop(c, x0, mu[3])
+	q4 = c
+
+	c, q1 = umulHop(q1, x1, mu[0])
+	c, q2 = umulStep(q2, x1, mu[1], c)
+	c, q3 = umulStep(q3, x1, mu[2], c)
+	c, q4 = umulStep(q4, x1, mu[3], c)
+	q5 = c
+
+	c, q2 = umulHop(q2, x2, mu[0])
+	c, q3 = umulStep(q3, x2, mu[1], c)
+	c, q4 = umulStep(q4, x2, mu[2], c)
+	c, q5 = umulStep(q5, x2, mu[3], c)
+	q6 = c
+
+	c, q3 = umulHop(q3, x3, mu[0])
+	c, q4 = umulStep(q4, x3, mu[1], c)
+	c, q5 = umulStep(q5, x3, mu[2], c)
+	c, q6 = umulStep(q6, x3, mu[3], c)
+	q7 = c
+
+	// Drop the fractional part of q3
+
+	q0 = q4
+	q1 = q5
+	q2 = q6
+	q3 = q7
+
+	// r2 = q3 * m mod 2^256
+
+	var r0, r1, r2, r3 uint64
+
+	c, r0 = bits.Mul64(q0, m[0])
+	c, r1 = umulHop(c, q0, m[1])
+	c, r2 = umulHop(c, q0, m[2])
+	r3 = c
+
+	c, r1 = umulHop(r1, q1, m[0])
+	c, r2 = umulStep(r2, q1, m[1], c)
+	r3 += q1*m[2] + c
+
+	c, r2 = umulHop(r2, q2, m[0])
+	r3 += q2*m[1] + c
+
+	r3 += q3 * m[0]
+
+	// r = r1 - r2, where r1 = x mod 2^256; as 0 <= r < 4m, the
+	// difference modulo 2^256 is exact
+
+	var b uint64
+
+	r0, b = bits.Sub64(x[0], r0, 0)
+	r1, b = bits.Sub64(x[1], r1, b)
+	r2, b = bits.Sub64(x[2], r2, b)
+	r3, _ = bits.Sub64(x[3], r3, b)
+
+	// while (r>=m) r-=m
+
+	for {
+		// q = r - m
+		q0, b = bits.Sub64(r0, m[0], 0)
+		q1, b = bits.Sub64(r1, m[1], b)
+		q2, b = bits.Sub64(r2, m[2], b)
+		q3, b = bits.Sub64(r3, 0, b)
+
+		// if borrow break
+		if b != 0 {
+			break
+		}
+
+		// r = q
+		r3, r2, r1, r0 = q3, q2, q1, q0
+	}
+
+	z[2], z[1], z[0] = r2, r1, r0
+
+	return z
+}
+
+
 // reduce4 computes the least non-negative residue of x modulo m
 //
-// requires a four-word modulus (m[3] > 1) and its inverse (mu)
+// requires a four-word modulus (m[3] != 0) and its inverse (mu)
 func reduce4(x [8]uint64, m *Int, mu [5]uint64) (z Int) {
 
 	// NB: Most variable names in the comments match the pseudocode for
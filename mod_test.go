@@ -4,7 +4,10 @@
 
 package uint256
 
-import "testing"
+import (
+	"math/big"
+	"testing"
+)
 
 func TestLeadingZeros(t *testing.T) {
 	one := Int{1, 0, 0, 0}
@@ -45,3 +48,70 @@ func TestLeadingZeros(t *testing.T) {
 		t.Errorf("wrong leading zeros %d of %x", z, x)
 	}
 }
+
+func TestReciprocalSmall(t *testing.T) {
+	check := func(m *Int) {
+		t.Helper()
+		k := (m.BitLen() + 63) / 64
+		mu := Reciprocal(m)
+		want := new(big.Int).Lsh(big.NewInt(1), uint(128*k))
+		want.Div(want, m.ToBig())
+		if want.BitLen() > 64*(k+1) {
+			want.Sub(want, big.NewInt(1))
+		}
+		have := new(big.Int)
+		for i := 4; i >= 0; i-- {
+			have.Lsh(have, 64).Add(have, new(big.Int).SetUint64(mu[i]))
+		}
+		if have.Cmp(want) != 0 {
+			t.Fatalf("Reciprocal(%#x): have %#x want %#x", m, have, want)
+		}
+		// Reduced operands, as in ExpMod, and full-width ones.
+		bm := m.ToBig()
+		var operands []*Int
+		for i := 0; i < 20; i++ {
+			_, x, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			operands = append(operands, x, new(Int).Mod(x, m))
+		}
+		operands = append(operands, new(Int).SetAllOne(), new(Int).SubUint64(m, 1), NewInt(1))
+		for _, x := range operands {
+			for _, y := range operands {
+				want := new(big.Int).Mul(x.ToBig(), y.ToBig())
+				want.Mod(want, bm)
+				if z := new(Int).MulModWithReciprocal(x, y, m, &mu); !checkEq(want, z) {
+					t.Fatalf("MulModWithReciprocal(%#x, %#x, %#x): have %#x want %#x", x, y, m, z, want)
+				}
+				// Without a reciprocal, MulModWithReciprocal falls back to MulMod.
+				if z := new(Int).MulModWithReciprocal(x, y, m, &[5]uint64{}); !checkEq(want, z) {
+					t.Fatalf("MulModWithReciprocal(%#x, %#x, %#x, 0): have %#x want %#x", x, y, m, z, want)
+				}
+			}
+		}
+	}
+	for k := uint(0); k < 3; k++ {
+		// The powers of 2^64, where the reciprocal does not fit.
+		check(new(Int).Lsh(NewInt(1), 64*k))
+		check(new(Int).Lsh(NewInt(1), 64*k+1))
+		check(new(Int).Lsh(NewInt(1), 64*k+63))
+		check(new(Int).Lsh(NewInt(3), 64*k))
+		check(new(Int).SubUint64(new(Int).Lsh(NewInt(1), 64*(k+1)), 1))
+		check(new(Int).AddUint64(new(Int).Lsh(NewInt(1), 64*k), 1))
+		for i := 0; i < 50; i++ {
+			_, m, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			m.Rsh(m, 64*(3-k)+uint(m[3]%64))
+			if m.IsZero() {
+				continue
+			}
+			check(m)
+		}
+	}
+	if mu := Reciprocal(new(Int)); mu != [5]uint64{} {
+		t.Fatalf("Reciprocal(0): have %x want 0", mu)
+	}
+}
@@ -0,0 +1,174 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "fmt"
+
+// Jacobi returns the Jacobi symbol (x/y), either +1, -1, or 0. For a prime y,
+// this is the Legendre symbol, which tells whether x is a square mod y.
+// The y argument must be an odd integer.
+func Jacobi(x, y *Int) int {
+	if y[0]&1 == 0 {
+		panic(fmt.Sprintf("uint256: invalid 2nd argument to Jacobi: need odd integer but got %s", y.Dec()))
+	}
+	// Binary algorithm: factors of two are taken out of a using the second
+	// supplement (2/n) = (-1)**((n*n-1)/8), and a is reduced by subtracting
+	// n, after swapping them with quadratic reciprocity if needed.
+	var (
+		a, n Int
+		j    = 1
+	)
+	a.Mod(x, y)
+	n.Set(y)
+	for !a.IsZero() {
+		tz := trailingZeros(&a)
+		a.Rsh(&a, tz)
+		if r := n[0] & 7; tz&1 == 1 && (r == 3 || r == 5) {
+			j = -j
+		}
+		if a.Lt(&n) {
+			a, n = n, a
+			if a[0]&3 == 3 && n[0]&3 == 3 {
+				j = -j
+			}
+		}
+		a.Sub(&a, &n)
+	}
+	// n == gcd(x, y)
+	if n.IsUint64() && n[0] == 1 {
+		return j
+	}
+	return 0
+}
+
+// ModSqrt sets z to a square root of x mod p if such a square root exists,
+// and returns z and true. The modulus p must be an odd prime. If x is not a
+// square mod p, or p is even, z is unmodified and false is returned.
+// The result is undefined if p is not prime (OBS: differs from the big.Int,
+// which panics for even p).
+func (z *Int) ModSqrt(x, p *Int) (*Int, bool) {
+	if p[0]&1 == 0 {
+		return z, false
+	}
+	switch Jacobi(x, p) {
+	case -1:
+		return z, false // x is not a square mod p
+	case 0:
+		return z.Clear(), true // sqrt(0) mod p = 0
+	}
+	var xr Int
+	xr.Mod(x, p)
+	switch {
+	case p[0]&3 == 3:
+		// Use the fast path for p ≡ 3 mod 4.
+		return z.modSqrt3Mod4Prime(&xr, p), true
+	case p[0]&7 == 5:
+		// Use the fast path for p ≡ 5 mod 8.
+		return z.modSqrt5Mod8Prime(&xr, p), true
+	default:
+		// Otherwise, use Tonelli-Shanks.
+		return z.modSqrtTonelliShanks(&xr, p)
+	}
+}
+
+// modSqrt3Mod4Prime uses the identity
+//
+//	(a^((p+1)/4))^2  mod p
+//	== u^(p+1)       mod p
+//	== u^2           mod p
+//
+// to calculate the square root of any quadratic residue mod p quickly for
+// 3 mod 4 primes.
+func (z *Int) modSqrt3Mod4Prime(x, p *Int) *Int {
+	var e Int
+	e.Rsh(p, 2).AddUint64(&e, 1) // e = (p + 1) / 4, without overflow
+	return z.ExpMod(x, &e, p)
+}
+
+// modSqrt5Mod8Prime uses Atkin's observation that 2 is not a square mod p
+//
+//	alpha ==  (2*a)^((p-5)/8)    mod p
+//	beta  ==  2*a*alpha^2        mod p  is a square root of -1
+//	b     ==  a*alpha*(beta-1)   mod p  is a square root of a
+//
+// to calculate the square root of any quadratic residue mod p quickly for
+// 5 mod 8 primes.
+func (z *Int) modSqrt5Mod8Prime(x, p *Int) *Int {
+	var (
+		mu                 = Reciprocal(p)
+		e, tx, alpha, beta Int
+	)
+	e.Rsh(p, 3)        // e = (p - 5) / 8
+	tx.AddMod(x, x, p) // tx = 2*x
+	alpha.ExpMod(&tx, &e, p)
+	beta.MulModWithReciprocal(&alpha, &alpha, p, &mu)
+	beta.MulModWithReciprocal(&beta, &tx, p, &mu)
+	beta.SubUint64(&beta, 1)
+	beta.MulModWithReciprocal(&beta, x, p, &mu)
+	return z.MulModWithReciprocal(&beta, &alpha, p, &mu)
+}
+
+// maxNonSquare bounds the search for a non-square in modSqrtTonelliShanks.
+// Under the generalized Riemann hypothesis, the least non-square modulo a
+// prime p is below 2*ln(p)^2, which is less than 63000 for p < 2^256.
+const maxNonSquare = 1 << 16
+
+// modSqrtTonelliShanks uses the Tonelli-Shanks algorithm to find the square
+// root of a quadratic residue modulo any prime, and returns z and true. If p
+// turns out not to be prime, z is unmodified and false may be returned, as
+// when no non-square n with Jacobi(n, p) = -1 exists for a perfect square p.
+func (z *Int) modSqrtTonelliShanks(x, p *Int) (*Int, bool) {
+	mu := Reciprocal(p)
+	// Break p-1 into s*2^e such that s is odd.
+	var s Int
+	s.SubUint64(p, 1)
+	e := trailingZeros(&s)
+	s.Rsh(&s, e)
+
+	// find some non-square n
+	n := NewInt(2)
+	for Jacobi(n, p) != -1 {
+		if n.AddUint64(n, 1).Cmp(p) >= 0 || n[0] > maxNonSquare {
+			return z, false
+		}
+	}
+
+	// Core of the Tonelli-Shanks algorithm. Follows the description in
+	// section 6 of "Square roots from 1; 24, 51, 10 to Dan Shanks" by Ezra
+	// Brown:
+	// https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+	var y, b, g, t Int
+	y.Rsh(&s, 1).AddUint64(&y, 1) // (s + 1) / 2, as s is odd
+	y.ExpMod(x, &y, p)            // y = x^((s+1)/2)
+	b.ExpMod(x, &s, p)            // b = x^s
+	g.ExpMod(n, &s, p)            // g = n^s
+	r := e
+	for {
+		// find the least m such that ord_p(b) = 2^m
+		var m uint
+		t.Set(&b)
+		for !(t.IsUint64() && t[0] == 1) {
+			t.MulModWithReciprocal(&t, &t, p, &mu)
+			// m < r holds for prime p, and makes r decrease.
+			if m++; m >= r {
+				return z, false
+			}
+		}
+
+		if m == 0 {
+			return z.Set(&y), true
+		}
+
+		// t = g^(2^(r-m-1)) mod p
+		t.Set(&g)
+		for i := uint(0); i < r-m-1; i++ {
+			t.MulModWithReciprocal(&t, &t, p, &mu)
+		}
+		g.MulModWithReciprocal(&t, &t, p, &mu) // g = g^(2^(r-m)) mod p
+		y.MulModWithReciprocal(&y, &t, p, &mu)
+		b.MulModWithReciprocal(&b, &g, p, &mu)
+		r = m
+	}
+}
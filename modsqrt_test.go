@@ -0,0 +1,157 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+var modSqrtPrimes = []string{
+	"0x3",
+	"0x5",
+	"0x7",
+	"0x11",
+	"0x61", // 97 ≡ 1 mod 32
+	"0xffffffffffffffc5",
+	"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", // secp256k1 p ≡ 3 mod 4
+	"0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", // secp256k1 n ≡ 1 mod 64
+	"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47", // bn254 p ≡ 3 mod 4
+	"0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001", // bn254 r ≡ 1 mod 2**28
+	"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", // 2**255-19 ≡ 5 mod 8
+	"0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff", // p256 p ≡ 3 mod 4
+	"0xffffffffffffffffffffffffffffffff000000000000000000000001",         // p224 p ≡ 1 mod 2**96
+}
+
+func mustModSqrtPrime(t *testing.T, hex string) *Int {
+	t.Helper()
+	p, err := FromHex(hex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// testModSqrt checks ModSqrt against (*big.Int).ModSqrt.
+func testModSqrt(t *testing.T, x, p *Int) {
+	t.Helper()
+	z := NewInt(1337)
+	res, ok := z.ModSqrt(x, p)
+	if res != z {
+		t.Fatalf("unexpected pointer returned: %p, expected: %p", res, z)
+	}
+	want := new(big.Int).ModSqrt(x.ToBig(), p.ToBig())
+	if want == nil {
+		if ok || !z.Eq(NewInt(1337)) {
+			t.Fatalf("ModSqrt(%#x, %#x): have %#x, %v; expected no square root and z unmodified", x, p, z, ok)
+		}
+		return
+	}
+	if !ok || !checkEq(want, z) {
+		t.Fatalf("ModSqrt(%#x, %#x): have %#x, %v want %#x", x, p, z, ok, want)
+	}
+	// Check aliasing of the operands.
+	y := x.Clone()
+	if y.ModSqrt(y, p); !y.Eq(z) {
+		t.Fatalf("ModSqrt(%#x, %#x) with z == x: have %#x want %#x", x, p, y, z)
+	}
+	y = p.Clone()
+	if y.ModSqrt(x, y); !y.Eq(z) {
+		t.Fatalf("ModSqrt(%#x, %#x) with z == p: have %#x want %#x", x, p, y, z)
+	}
+}
+
+func TestModSqrt(t *testing.T) {
+	for _, hex := range modSqrtPrimes {
+		p := mustModSqrtPrime(t, hex)
+		values := []*Int{
+			NewInt(0), NewInt(1), NewInt(2), NewInt(3), NewInt(4), NewInt(5),
+			new(Int).SetAllOne(),
+			new(Int).SubUint64(p, 1),
+			p,
+			new(Int).AddUint64(p, 4),
+		}
+		for _, x := range values {
+			testModSqrt(t, x, p)
+		}
+		for i := 0; i < 500; i++ {
+			_, x, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			testModSqrt(t, x, p)
+			// Squares are always expected to have a root.
+			sq := new(Int).MulMod(x, x, p)
+			testModSqrt(t, sq, p)
+		}
+	}
+	// Even moduli are rejected.
+	z := NewInt(1337)
+	if _, ok := z.ModSqrt(NewInt(4), NewInt(8)); ok || !z.Eq(NewInt(1337)) {
+		t.Fatalf("ModSqrt(4, 8): have %#x, %v; expected no square root and z unmodified", z, ok)
+	}
+	// Composite moduli give undefined results, but must not hang. There is
+	// no non-square modulo a perfect square, or modulo 1, so Tonelli-Shanks
+	// finds no root.
+	m127 := new(Int).SubUint64(new(Int).Lsh(NewInt(1), 127), 1)
+	for _, p := range []*Int{NewInt(1), NewInt(9), NewInt(25), new(Int).Mul(m127, m127)} {
+		if _, ok := z.ModSqrt(NewInt(4), p); ok || !z.Eq(NewInt(1337)) {
+			t.Fatalf("ModSqrt(4, %#x): have %#x, %v; expected no square root and z unmodified", p, z, ok)
+		}
+	}
+	// 697 = 17 * 41 = 1 mod 8 has non-squares, so only the result is undefined.
+	for x := uint64(0); x < 697; x++ {
+		new(Int).ModSqrt(NewInt(x), NewInt(697))
+	}
+}
+
+func testJacobi(t *testing.T, x, y *Int) {
+	t.Helper()
+	have := Jacobi(x, y)
+	if want := big.Jacobi(x.ToBig(), y.ToBig()); have != want {
+		t.Fatalf("Jacobi(%#x, %#x): have %d want %d", x, y, have, want)
+	}
+}
+
+func TestRandomJacobi(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, y, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		y[0] |= 1
+		testJacobi(t, x, y)
+		// Share a small factor to exercise the zero case.
+		testJacobi(t, new(Int).Mul(x, NewInt(3)), new(Int).Mul(y, NewInt(3)))
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	values := []*Int{
+		NewInt(0), NewInt(1), NewInt(2), NewInt(3), NewInt(4), NewInt(7), NewInt(8), NewInt(15),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+	}
+	for _, hex := range modSqrtPrimes {
+		values = append(values, mustModSqrtPrime(t, hex))
+	}
+	for _, x := range values {
+		for _, y := range values {
+			if y[0]&1 == 1 {
+				testJacobi(t, x, y)
+			}
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Jacobi(1, 2): expected panic")
+		}
+	}()
+	Jacobi(NewInt(1), NewInt(2))
+}
@@ -0,0 +1,180 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// Modulus is a modulus m prepared for repeated arithmetic in the ring ℤ/mℤ.
+// It caches the reciprocal of m, so that Mul, Sqr and Exp use Barrett
+// reduction without recomputing it, as MulModWithReciprocal does.
+//
+// The arithmetic methods always return canonical residues, that is values
+// below m. They accept any inputs, and reduce those which are not canonical,
+// so passing residues as returned by Reduce and Reduce512 avoids that work.
+// Like the Int methods, they set z to the result and return z, and z may
+// alias the arguments. The zero value is not usable; use NewModulus.
+type Modulus struct {
+	m  Int
+	mu [5]uint64 // reciprocal of m, see Reciprocal
+	k  int       // number of words of m
+}
+
+// NewModulus returns a Modulus for m. It panics if m == 0.
+func NewModulus(m *Int) *Modulus {
+	if m.IsZero() {
+		panic("uint256: zero modulus")
+	}
+	return &Modulus{m: *m, mu: Reciprocal(m), k: (m.BitLen() + 63) / 64}
+}
+
+// Int returns the value of the modulus.
+func (md *Modulus) Int() *Int {
+	return md.m.Clone()
+}
+
+// reduce sets z to x mod m, and returns z.
+func (md *Modulus) reduce(z *Int, x *[8]uint64) *Int {
+	if md.k == 4 {
+		*z = reduce4(*x, &md.m, md.mu)
+	} else {
+		*z = reduceSmall(x, &md.m, &md.mu, md.k)
+	}
+	return z
+}
+
+// Reduce sets z to the canonical residue x mod m, for any x, and returns z.
+func (md *Modulus) Reduce(z, x *Int) *Int {
+	if x.Lt(&md.m) {
+		return z.Set(x)
+	}
+	p := [8]uint64{x[0], x[1], x[2], x[3]}
+	return md.reduce(z, &p)
+}
+
+// canonical returns x if it is a canonical residue, and otherwise sets buf
+// to x mod m and returns buf.
+func (md *Modulus) canonical(buf, x *Int) *Int {
+	if x.Lt(&md.m) {
+		return x
+	}
+	// For x < 2m, a single subtraction of m suffices.
+	if _, borrow := buf.SubOverflow(x, &md.m); !borrow && buf.Lt(&md.m) {
+		return buf
+	}
+	return md.Reduce(buf, x)
+}
+
+// Reduce512 sets z to the canonical residue (hi*2^256 + lo) mod m, for any
+// hi and lo, and returns z.
+func (md *Modulus) Reduce512(z, hi, lo *Int) *Int {
+	p := [8]uint64{lo[0], lo[1], lo[2], lo[3], hi[0], hi[1], hi[2], hi[3]}
+	return md.reduce(z, &p)
+}
+
+// Add sets z to the sum x+y mod m, and returns z.
+func (md *Modulus) Add(z, x, y *Int) *Int {
+	var (
+		a, b, s, t    Int
+		carry, borrow uint64
+	)
+	x, y = md.canonical(&a, x), md.canonical(&b, y)
+	s[0], carry = bits.Add64(x[0], y[0], 0)
+	s[1], carry = bits.Add64(x[1], y[1], carry)
+	s[2], carry = bits.Add64(x[2], y[2], carry)
+	s[3], carry = bits.Add64(x[3], y[3], carry)
+
+	t[0], borrow = bits.Sub64(s[0], md.m[0], 0)
+	t[1], borrow = bits.Sub64(s[1], md.m[1], borrow)
+	t[2], borrow = bits.Sub64(s[2], md.m[2], borrow)
+	t[3], borrow = bits.Sub64(s[3], md.m[3], borrow)
+
+	// x+y >= m if the sum overflowed, or m can be subtracted from it.
+	if carry != 0 || borrow == 0 {
+		return z.Set(&t)
+	}
+	return z.Set(&s)
+}
+
+// Sub sets z to the difference x-y mod m, and returns z.
+func (md *Modulus) Sub(z, x, y *Int) *Int {
+	var (
+		a, b, d       Int
+		borrow, carry uint64
+	)
+	x, y = md.canonical(&a, x), md.canonical(&b, y)
+	d[0], borrow = bits.Sub64(x[0], y[0], 0)
+	d[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	d[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	d[3], borrow = bits.Sub64(x[3], y[3], borrow)
+
+	if borrow != 0 {
+		d[0], carry = bits.Add64(d[0], md.m[0], 0)
+		d[1], carry = bits.Add64(d[1], md.m[1], carry)
+		d[2], carry = bits.Add64(d[2], md.m[2], carry)
+		d[3], _ = bits.Add64(d[3], md.m[3], carry)
+	}
+	return z.Set(&d)
+}
+
+// Neg sets z to -x mod m, and returns z.
+func (md *Modulus) Neg(z, x *Int) *Int {
+	var a Int
+	if x = md.canonical(&a, x); x.IsZero() {
+		return z.Clear()
+	}
+	return z.Sub(&md.m, x)
+}
+
+// Mul sets z to the product x*y mod m, and returns z.
+func (md *Modulus) Mul(z, x, y *Int) *Int {
+	p := umul(x, y)
+	return md.reduce(z, &p)
+}
+
+// Sqr sets z to the square x*x mod m, and returns z.
+func (md *Modulus) Sqr(z, x *Int) *Int {
+	p := umul(x, x)
+	return md.reduce(z, &p)
+}
+
+// Exp sets z = x**exponent mod m, and returns z.
+// As with ExpMod, x**0 is 1 for m > 1, and anything mod 1 is 0.
+func (md *Modulus) Exp(z, x, exponent *Int) *Int {
+	var (
+		table [16]Int // table[i] = x**i mod m
+		res   Int
+	)
+	table[0].SetOne()
+	md.Reduce(&table[0], &table[0])
+	md.Reduce(&table[1], x)
+	for i := 2; i < len(table); i++ {
+		md.Mul(&table[i], &table[i-1], &table[1])
+	}
+	// Left-to-right exponentiation with a fixed 4-bit window: the top
+	// window is used as the starting value, then each following window
+	// takes four squarings and a single multiplication.
+	n := (exponent.BitLen() + 3) / 4 // number of windows
+	if n == 0 {
+		return z.Set(&table[0])
+	}
+	res = table[exponent.window4(n-1)]
+	for i := n - 2; i >= 0; i-- {
+		md.Sqr(&res, &res)
+		md.Sqr(&res, &res)
+		md.Sqr(&res, &res)
+		md.Sqr(&res, &res)
+		if w := exponent.window4(i); w != 0 {
+			md.Mul(&res, &res, &table[w])
+		}
+	}
+	return z.Set(&res)
+}
+
+// Inv sets z to the multiplicative inverse of x mod m, and returns z and
+// true. If x has no inverse, that is, x and m are not relatively prime, z is
+// unmodified and false is returned.
+func (md *Modulus) Inv(z, x *Int) (*Int, bool) {
+	return z.ModInverse(x, &md.m)
+}
@@ -0,0 +1,125 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testModulus checks the Modulus methods for m on the residues x and y, and
+// on the unreduced e, against big.Int.
+func testModulus(t *testing.T, md *Modulus, x, y, e *Int) {
+	t.Helper()
+	var (
+		m      = md.Int().ToBig()
+		bx, by = x.ToBig(), y.ToBig()
+		be     = e.ToBig()
+	)
+	check := func(name string, have *Int, want *big.Int) {
+		t.Helper()
+		if !checkEq(want.Mod(want, m), have) {
+			t.Fatalf("%s(%#x, %#x) mod %#x: have %#x want %#x", name, x, y, m, have, want)
+		}
+	}
+	check("Add", md.Add(new(Int), x, y), new(big.Int).Add(bx, by))
+	check("Sub", md.Sub(new(Int), x, y), new(big.Int).Sub(bx, by))
+	check("Neg", md.Neg(new(Int), x), new(big.Int).Neg(bx))
+	check("Mul", md.Mul(new(Int), x, y), new(big.Int).Mul(bx, by))
+	check("Sqr", md.Sqr(new(Int), x), new(big.Int).Mul(bx, bx))
+	check("Exp", md.Exp(new(Int), x, e), new(big.Int).Exp(bx, be, m))
+	check("Reduce", md.Reduce(new(Int), e), new(big.Int).Set(be))
+	check("Reduce512", md.Reduce512(new(Int), e, y), fromFull(e, y))
+
+	// The results must be the same when z aliases the arguments.
+	check("Add", md.Add(x.Clone(), x, y), new(big.Int).Add(bx, by))
+	if z := y.Clone(); !md.Sub(z, x, z).Eq(md.Sub(new(Int), x, y)) {
+		t.Fatalf("Sub(%#x, %#x) mod %#x with z == y: have %#x", x, y, m, z)
+	}
+	if z := x.Clone(); !md.Mul(z, z, z).Eq(md.Sqr(new(Int), x)) {
+		t.Fatalf("Mul(%#x, %#x) mod %#x with z == x == y: have %#x", x, x, m, z)
+	}
+	if z := e.Clone(); !md.Exp(z, x, z).Eq(md.Exp(new(Int), x, e)) {
+		t.Fatalf("Exp(%#x, %#x) mod %#x with z == exponent: have %#x", x, e, m, z)
+	}
+
+	want := new(big.Int).ModInverse(bx, m)
+	if m.Cmp(big.NewInt(1)) == 0 {
+		want = new(big.Int) // big.Int fails to invert modulo 1
+	}
+	z, ok := md.Inv(new(Int), x)
+	if ok != (want != nil) || (ok && !checkEq(want, z)) {
+		t.Fatalf("Inv(%#x) mod %#x: have %#x, %v want %#x", x, m, z, ok, want)
+	}
+}
+
+func TestModulus(t *testing.T) {
+	var moduli []*Int
+	for _, s := range []string{
+		"1",
+		"2",
+		"3",
+		"0xffffffff00000001",
+		"0xffffffffffffffff",
+		"0x10000000000000000",
+		"0x10000000000000001",
+		"0xfffffffffffffffffffffffffffffffe",
+		"0x1000000000000000000000000000000000000000000000000",
+		"0xfffffffffffffffffffffffffffffffeffffffffffffffff",
+		"0x1000000000000000000000000000000000000000000000000000000000000",
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		"0x8000000000000000000000000000000000000000000000000000000000000000",
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	} {
+		b, _ := new(big.Int).SetString(s, 0)
+		m, _ := FromBig(b)
+		moduli = append(moduli, m)
+	}
+	for i := 0; i < 100; i++ {
+		_, m, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		if !m.IsZero() {
+			moduli = append(moduli, m)
+		}
+	}
+	max := new(Int).SetAllOne()
+	for _, m := range moduli {
+		md := NewModulus(m)
+		mMinus1 := new(Int).Sub(m, NewInt(1))
+		testModulus(t, md, new(Int), new(Int), new(Int))
+		testModulus(t, md, mMinus1, mMinus1, max)
+		testModulus(t, md, mMinus1, new(Int), mMinus1)
+		// Inputs which are not canonical residues give canonical results,
+		// both below 2m and above.
+		twoMMinus1, overflow := new(Int).AddOverflow(m, mMinus1)
+		if overflow {
+			twoMMinus1 = max
+		}
+		testModulus(t, md, m, new(Int).AddUint64(m, 1), max)
+		testModulus(t, md, twoMMinus1, m, twoMMinus1)
+		testModulus(t, md, max, twoMMinus1, m)
+		for i := 0; i < 100; i++ {
+			_, x, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			_, y, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			e := x.Clone()
+			testModulus(t, md, md.Reduce(x, x), md.Reduce(y, y), e)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewModulus(0): expected panic")
+		}
+	}()
+	NewModulus(new(Int))
+}
@@ -0,0 +1,154 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// Montgomery is an odd modulus m prepared for arithmetic in Montgomery form,
+// where a residue x is represented by x*R mod m, with R = 2^256. Converting
+// to and from the form costs a multiplication each way, but a Montgomery
+// multiplication replaces the division by m with word-by-word reduction, so
+// long chains of multiplications, such as exponentiations, are cheaper than
+// with MulMod or MulModWithReciprocal.
+//
+// The arithmetic methods take residues in Montgomery form, as returned by
+// ToMont, and return results in Montgomery form. Like the Int methods, they
+// set z to the result and return z, and z may alias the arguments. The zero
+// value is not usable; use NewMontgomery.
+type Montgomery struct {
+	m    Int
+	mInv uint64 // -m^-1 mod 2^64
+	one  Int    // R mod m, that is 1 in Montgomery form
+	r2   Int    // R^2 mod m
+}
+
+// NewMontgomery returns a Montgomery context for m. It panics if m is even,
+// as the Montgomery form only exists for odd moduli.
+func NewMontgomery(m *Int) *Montgomery {
+	if m[0]&1 == 0 {
+		panic("uint256: even Montgomery modulus")
+	}
+	mt := &Montgomery{m: *m}
+
+	// Newton's iteration doubles the number of correct low bits of the
+	// inverse; m*m = 1 mod 8 holds for any odd m, so m has 3 of them.
+	inv := m[0]
+	for i := 0; i < 5; i++ {
+		inv *= 2 - m[0]*inv
+	}
+	mt.mInv = -inv
+
+	var (
+		u    = [5]uint64{0, 0, 0, 0, 1}
+		quot [5]uint64
+	)
+	mt.one = udivrem(quot[:], u[:], m)
+	mt.r2.MulMod(&mt.one, &mt.one, m)
+	return mt
+}
+
+// Int returns the value of the modulus.
+func (mt *Montgomery) Int() *Int {
+	return mt.m.Clone()
+}
+
+// mulMont returns x*y*R^-1 mod m, for x*y < m*R, using the coarsely
+// integrated operand scanning (CIOS) method: each word of y is multiplied
+// into the accumulator, which is then divided by 2^64 after adding the
+// multiple of m that clears its low word. The accumulator stays below 2m.
+func (mt *Montgomery) mulMont(x, y *Int) Int {
+	var (
+		m                  = &mt.m
+		t0, t1, t2, t3, t4 uint64
+		t5, c, q           uint64
+	)
+	for i := 0; i < 4; i++ {
+		c, t0 = umulHop(t0, x[0], y[i])
+		c, t1 = umulStep(t1, x[1], y[i], c)
+		c, t2 = umulStep(t2, x[2], y[i], c)
+		c, t3 = umulStep(t3, x[3], y[i], c)
+		t4, t5 = bits.Add64(t4, c, 0)
+
+		q = t0 * mt.mInv
+		c, _ = umulHop(t0, q, m[0])
+		c, t0 = umulStep(t1, q, m[1], c)
+		c, t1 = umulStep(t2, q, m[2], c)
+		c, t2 = umulStep(t3, q, m[3], c)
+		t3, c = bits.Add64(t4, c, 0)
+		t4 = t5 + c
+	}
+
+	var (
+		z      Int
+		borrow uint64
+	)
+	z[0], borrow = bits.Sub64(t0, m[0], 0)
+	z[1], borrow = bits.Sub64(t1, m[1], borrow)
+	z[2], borrow = bits.Sub64(t2, m[2], borrow)
+	z[3], borrow = bits.Sub64(t3, m[3], borrow)
+	if t4 == 0 && borrow != 0 {
+		return Int{t0, t1, t2, t3}
+	}
+	return z
+}
+
+// ToMont sets z to x in Montgomery form, x*R mod m, for any x, and returns z.
+func (mt *Montgomery) ToMont(z, x *Int) *Int {
+	*z = mt.mulMont(x, &mt.r2)
+	return z
+}
+
+// FromMont sets z to the residue x*R^-1 mod m, that is the value represented
+// by x in Montgomery form, and returns z.
+func (mt *Montgomery) FromMont(z, x *Int) *Int {
+	*z = mt.mulMont(x, &Int{1})
+	return z
+}
+
+// MulMont sets z to the Montgomery product x*y*R^-1 mod m, that is the
+// Montgomery form of the product of the values x and y represent, and
+// returns z.
+func (mt *Montgomery) MulMont(z, x, y *Int) *Int {
+	*z = mt.mulMont(x, y)
+	return z
+}
+
+// SqrMont sets z to the Montgomery square x*x*R^-1 mod m, and returns z.
+func (mt *Montgomery) SqrMont(z, x *Int) *Int {
+	*z = mt.mulMont(x, x)
+	return z
+}
+
+// ExpMont sets z to x**exponent in Montgomery form, where x is in Montgomery
+// form and the exponent is not, and returns z. As with ExpMod, x**0 is 1 for
+// m > 1, and anything mod 1 is 0.
+func (mt *Montgomery) ExpMont(z, x, exponent *Int) *Int {
+	var (
+		table [16]Int // table[i] = x**i in Montgomery form
+		res   Int
+	)
+	table[0] = mt.one
+	table[1] = *x
+	for i := 2; i < len(table); i++ {
+		table[i] = mt.mulMont(&table[i-1], &table[1])
+	}
+	// Left-to-right exponentiation with a fixed 4-bit window, as in
+	// Modulus.Exp.
+	n := (exponent.BitLen() + 3) / 4 // number of windows
+	if n == 0 {
+		return z.Set(&table[0])
+	}
+	res = table[exponent.window4(n-1)]
+	for i := n - 2; i >= 0; i-- {
+		res = mt.mulMont(&res, &res)
+		res = mt.mulMont(&res, &res)
+		res = mt.mulMont(&res, &res)
+		res = mt.mulMont(&res, &res)
+		if w := exponent.window4(i); w != 0 {
+			res = mt.mulMont(&res, &table[w])
+		}
+	}
+	return z.Set(&res)
+}
@@ -0,0 +1,115 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testMontgomery checks the Montgomery methods for m on x, y and the exponent
+// e against big.Int, and against MulMod and ExpMod.
+func testMontgomery(t *testing.T, mt *Montgomery, x, y, e *Int) {
+	t.Helper()
+	m := mt.Int()
+
+	xm := mt.ToMont(new(Int), x)
+	want := new(big.Int).Lsh(x.ToBig(), 256)
+	if !checkEq(want.Mod(want, m.ToBig()), xm) {
+		t.Fatalf("ToMont(%#x) mod %#x: have %#x want %#x", x, m, xm, want)
+	}
+	if have, want := mt.FromMont(new(Int), xm), new(Int).Mod(x, m); !have.Eq(want) {
+		t.Fatalf("FromMont(ToMont(%#x)) mod %#x: have %#x want %#x", x, m, have, want)
+	}
+	ym := mt.ToMont(new(Int), y)
+
+	have := mt.FromMont(new(Int), mt.MulMont(new(Int), xm, ym))
+	if want := new(Int).MulMod(x, y, m); !have.Eq(want) {
+		t.Fatalf("MulMont(%#x, %#x) mod %#x: have %#x want %#x", x, y, m, have, want)
+	}
+	have = mt.FromMont(new(Int), mt.SqrMont(new(Int), xm))
+	if want := new(Int).MulMod(x, x, m); !have.Eq(want) {
+		t.Fatalf("SqrMont(%#x) mod %#x: have %#x want %#x", x, m, have, want)
+	}
+	have = mt.FromMont(new(Int), mt.ExpMont(new(Int), xm, e))
+	if want := new(Int).ExpMod(x, e, m); !have.Eq(want) {
+		t.Fatalf("ExpMont(%#x, %#x) mod %#x: have %#x want %#x", x, e, m, have, want)
+	}
+
+	// The results must be the same when z aliases the arguments.
+	if z := x.Clone(); !mt.ToMont(z, z).Eq(xm) {
+		t.Fatalf("ToMont(%#x) mod %#x with z == x: have %#x want %#x", x, m, z, xm)
+	}
+	if z, want := xm.Clone(), mt.MulMont(new(Int), xm, ym); !mt.MulMont(z, z, ym).Eq(want) {
+		t.Fatalf("MulMont(%#x, %#x) mod %#x with z == x: have %#x want %#x", xm, ym, m, z, want)
+	}
+	if z, want := xm.Clone(), mt.SqrMont(new(Int), xm); !mt.MulMont(z, z, z).Eq(want) {
+		t.Fatalf("MulMont(%#x, %#x) mod %#x with z == x == y: have %#x want %#x", xm, xm, m, z, want)
+	}
+	if z, want := e.Clone(), mt.ExpMont(new(Int), xm, e); !mt.ExpMont(z, xm, z).Eq(want) {
+		t.Fatalf("ExpMont(%#x, %#x) mod %#x with z == exponent: have %#x want %#x", xm, e, m, z, want)
+	}
+}
+
+func TestMontgomery(t *testing.T) {
+	var moduli []*Int
+	for _, s := range []string{
+		"1",
+		"3",
+		"0xffffffff00000001",
+		"0xffffffffffffffff",
+		"0x10000000000000001",
+		"0xffffffffffffffffffffffffffffffff",
+		"0xfffffffffffffffffffffffffffffffeffffffffffffffff",
+		"0x1000000000000000000000000000000000000000000000001",
+		"0x30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47",
+		"0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001",
+		"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		"0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		"0x8000000000000000000000000000000000000000000000000000000000000001",
+		"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	} {
+		b, _ := new(big.Int).SetString(s, 0)
+		m, _ := FromBig(b)
+		moduli = append(moduli, m)
+	}
+	for i := 0; i < 100; i++ {
+		_, m, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		moduli = append(moduli, m.SetBit(m, 0, 1))
+	}
+	max := new(Int).SetAllOne()
+	for _, m := range moduli {
+		mt := NewMontgomery(m)
+		mMinus1 := new(Int).Sub(m, NewInt(1))
+		testMontgomery(t, mt, new(Int), new(Int), new(Int))
+		testMontgomery(t, mt, mMinus1, mMinus1, max)
+		testMontgomery(t, mt, max, max, mMinus1)
+		testMontgomery(t, mt, NewInt(1), m, NewInt(1))
+		for i := 0; i < 100; i++ {
+			_, x, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			_, y, err := randNums()
+			if err != nil {
+				t.Fatalf("Error getting a random number: %v", err)
+			}
+			testMontgomery(t, mt, x, y, new(Int).Xor(x, y))
+		}
+	}
+	for _, m := range []*Int{new(Int), NewInt(2), new(Int).Lsh(NewInt(3), 200)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("NewMontgomery(%#x): expected panic", m)
+				}
+			}()
+			NewMontgomery(m)
+		}()
+	}
+}
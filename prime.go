@@ -0,0 +1,244 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/bits"
+)
+
+// ProbablyPrime reports whether x is probably prime,
+// applying the Miller-Rabin test with n pseudorandomly chosen bases
+// as well as a Baillie-PSW test.
+//
+// If x is prime, ProbablyPrime returns true.
+// If x is chosen randomly and not prime, ProbablyPrime probably returns false.
+// The probability of returning true for a randomly chosen non-prime is at most ¼ⁿ.
+//
+// ProbablyPrime is 100% accurate for inputs less than 2⁶⁴.
+// ProbablyPrime(0) applies only the Baillie-PSW test, which has no known
+// counterexamples, and is deterministic. The pseudorandom bases are derived
+// from x, so the result for a given x and n never changes between calls.
+//
+// ProbablyPrime is not suitable for judging primes that an adversary may
+// have crafted to fool the test. It panics if n is negative.
+func (x *Int) ProbablyPrime(n int) bool {
+	if n < 0 {
+		panic("uint256: negative n for ProbablyPrime")
+	}
+
+	// primeBitMask records the primes < 64.
+	const primeBitMask uint64 = 1<<2 | 1<<3 | 1<<5 | 1<<7 |
+		1<<11 | 1<<13 | 1<<17 | 1<<19 | 1<<23 | 1<<29 | 1<<31 |
+		1<<37 | 1<<41 | 1<<43 | 1<<47 | 1<<53 | 1<<59 | 1<<61
+
+	if x.IsUint64() && x[0] < 64 {
+		return primeBitMask&(1<<x[0]) != 0
+	}
+	if x[0]&1 == 0 {
+		return false // x is even
+	}
+
+	const primesA = 3 * 5 * 7 * 11 * 13 * 17 * 19 * 23 * 37
+	const primesB = 29 * 31 * 41 * 43 * 47 * 53
+
+	var r uint64
+	for i := 3; i >= 0; i-- {
+		r = bits.Rem64(r, x[i], primesA*primesB)
+	}
+	rA, rB := r%primesA, r%primesB
+	if rA%3 == 0 || rA%5 == 0 || rA%7 == 0 || rA%11 == 0 || rA%13 == 0 || rA%17 == 0 || rA%19 == 0 || rA%23 == 0 || rA%37 == 0 ||
+		rB%29 == 0 || rB%31 == 0 || rB%41 == 0 || rB%43 == 0 || rB%47 == 0 || rB%53 == 0 {
+		return false
+	}
+
+	mu := Reciprocal(x)
+	return x.probablyPrimeMillerRabin(n+1, &mu) && x.probablyPrimeLucas(&mu)
+}
+
+// probablyPrimeMillerRabin reports whether x passes reps rounds of the
+// Miller-Rabin primality test, using pseudo-randomly chosen bases. The last
+// round always uses base 2. The number x is known to be odd and > 64, and
+// mu is its reciprocal, as used by MulModWithReciprocal.
+// See Handbook of Applied Cryptography, p. 139, Algorithm 4.24.
+func (x *Int) probablyPrimeMillerRabin(reps int, mu *[5]uint64) bool {
+	var (
+		xm1, q, xm3, a, y Int
+		one               = NewInt(1)
+	)
+	xm1.SubUint64(x, 1)
+	// determine q, k such that xm1 = q << k
+	k := trailingZeros(&xm1)
+	q.Rsh(&xm1, k)
+
+	xm3.SubUint64(&xm1, 2)
+	rnd := splitMix64(x[0])
+
+NextRandom:
+	for i := 0; i < reps; i++ {
+		if i == reps-1 {
+			a.SetUint64(2)
+		} else {
+			a.randomBelow(&rnd, &xm3)
+			a.AddUint64(&a, 2)
+		}
+		y.ExpMod(&a, &q, x)
+		if y.Eq(one) || y.Eq(&xm1) {
+			continue
+		}
+		for j := uint(1); j < k; j++ {
+			y.MulModWithReciprocal(&y, &y, x, mu)
+			if y.Eq(&xm1) {
+				continue NextRandom
+			}
+			if y.Eq(one) {
+				return false
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// probablyPrimeLucas reports whether x passes the "almost extra strong" Lucas
+// probable prime test, using Baillie-OEIS parameter selection. Together with
+// the Miller-Rabin test with base 2 this gives a Baillie-PSW test. The number
+// x is known to be odd and > 64, and mu is its reciprocal, as used by
+// MulModWithReciprocal.
+// This is a port of the math/big implementation, which has the references
+// and a discussion of the test variants.
+func (x *Int) probablyPrimeLucas(mu *[5]uint64) bool {
+	// Baillie-OEIS "method C" for choosing D, P, Q:
+	// try increasing P ≥ 3 such that D = P² - 4 (so Q = 1)
+	// until Jacobi(D, x) = -1.
+	// The search is expected to succeed for non-square x after just a few
+	// trials. After more than expected failures, check whether x is square
+	// (which would cause Jacobi(D, x) = 1 for all D not dividing x).
+	var (
+		p uint64
+		d Int
+	)
+	for p = 3; ; p++ {
+		if p > 10000 {
+			// This is widely believed to be impossible.
+			panic("uint256: internal error: cannot find (D/n) = -1 for " + x.Dec())
+		}
+		d.SetUint64(p*p - 4)
+		j := Jacobi(&d, x)
+		if j == -1 {
+			break
+		}
+		if j == 0 {
+			// d = p²-4 = (p-2)(p+2) shares the prime factor p+2 with x.
+			// If p+2 == x, then x is prime; otherwise p+2 is a proper factor.
+			return x.IsUint64() && x[0] == p+2
+		}
+		if p == 40 {
+			// We'll never find (d/x) = -1 if x is a square.
+			var t Int
+			if t.Sqrt(x); t.Mul(&t, &t).Eq(x) {
+				return false
+			}
+		}
+	}
+
+	// Arrange s = (x - Jacobi(Δ, x)) / 2^r = (x+1) / 2^r. As x is odd and
+	// not divisible by 3, x+1 does not overflow.
+	var s, xm2 Int
+	s.AddUint64(x, 1)
+	r := trailingZeros(&s)
+	s.Rsh(&s, r)
+	xm2.SubUint64(x, 2)
+
+	// Compute Lucas sequence V_s(b, 1), doubling the subscript with
+	//
+	//	V(2k) = V(k)² - 2
+	//	V(2k+1) = V(k) V(k+1) - P
+	//
+	// starting with V(0) = 2 and V(1) = P.
+	var (
+		vk, vk1 Int
+		natP    = NewInt(p)
+		two     = NewInt(2)
+	)
+	vk.SetUint64(2)
+	vk1.SetUint64(p)
+	for i := s.BitLen(); i >= 0; i-- {
+		if s.isBitSet(uint(i)) {
+			// k' = 2k+1
+			vk.MulModWithReciprocal(&vk, &vk1, x, mu).lucasSub(natP, x)
+			vk1.MulModWithReciprocal(&vk1, &vk1, x, mu).lucasSub(two, x)
+		} else {
+			// k' = 2k
+			vk1.MulModWithReciprocal(&vk, &vk1, x, mu).lucasSub(natP, x)
+			vk.MulModWithReciprocal(&vk, &vk, x, mu).lucasSub(two, x)
+		}
+	}
+
+	// Now k=s, so vk = V(s). Check V(s) ≡ ±2 (mod x).
+	if vk.Eq(two) || vk.Eq(&xm2) {
+		// Check U(s) ≡ 0, using U(k) = D⁻¹ (2 V(k+1) - P V(k)).
+		// It suffices to check P V(k) == 2 V(k+1) mod x.
+		var t1, t2 Int
+		t1.MulModWithReciprocal(&vk, natP, x, mu)
+		t2.AddMod(&vk1, &vk1, x)
+		if t1.Eq(&t2) {
+			return true
+		}
+	}
+
+	// Check V(2^t s) ≡ 0 mod x for some 0 ≤ t < r-1.
+	for t := uint(0); t+1 < r; t++ {
+		if vk.IsZero() {
+			return true
+		}
+		// Optimization: V(k) = 2 is a fixed point for V(k') = V(k)² - 2,
+		// so if V(k) = 2, we can stop: we will never find a future V(k) == 0.
+		if vk.Eq(two) {
+			return false
+		}
+		// k' = 2k
+		vk.MulModWithReciprocal(&vk, &vk, x, mu).lucasSub(two, x)
+	}
+	return false
+}
+
+// lucasSub sets z to z - y mod m, for z < m and y < m.
+func (z *Int) lucasSub(y, m *Int) *Int {
+	if z.Lt(y) {
+		var t Int
+		t.Sub(y, z)
+		return z.Sub(m, &t)
+	}
+	return z.Sub(z, y)
+}
+
+// splitMix64 is a minimal pseudorandom generator for choosing the
+// Miller-Rabin bases without allocating.
+type splitMix64 uint64
+
+func (s *splitMix64) next() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// randomBelow sets z to a pseudorandom value in [0, limit), for a non-zero limit.
+func (z *Int) randomBelow(rnd *splitMix64, limit *Int) *Int {
+	bitLen := limit.BitLen()
+	words := (bitLen + 63) / 64
+	mask := ^uint64(0) >> ((64 - bitLen%64) % 64)
+	for {
+		*z = Int{}
+		for i := 0; i < words; i++ {
+			z[i] = rnd.next()
+		}
+		z[words-1] &= mask
+		if z.Lt(limit) {
+			return z
+		}
+	}
+}
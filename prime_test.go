@@ -0,0 +1,178 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"testing"
+)
+
+var primes = []string{
+	"2",
+	"3",
+	"5",
+	"7",
+	"11",
+	"67",
+	"4294967291",
+
+	"13756265695458089029",
+	"13496181268022124907",
+	"10953742525620032441",
+	"17908251027575790097",
+	"18446744073709551557", // 2^64-59
+	"18699199384836356663",
+
+	"98920366548084643601728869055592650835572950932266967461790948584315647051443",
+	"94560208308847015747498523884063394671606671904944666360068158221458669711639",
+
+	"3618502788666131106986593281521497120414687020801267626233049500247285301239",   // 2^251-9
+	"57896044618658097711785492504343953926634992332820282019728792003956564819949",  // 2^255-19
+	"115792089237316195423570985008687907853269984665640564039457584007908834671663", // secp256k1 p
+	"115792089237316195423570985008687907852837564279074904382605163141518161494337", // secp256k1 n
+	"21888242871839275222246405745257275088696311157297823662689037894645226208583",  // bn254 p
+	"21888242871839275222246405745257275088548364400416034343698204186575808495617",  // bn254 r
+	"115792089237316195423570985008687907853269984665640564039457584007913129639747", // 2^256-189
+}
+
+var composites = []string{
+	"0",
+	"1",
+	"4",
+	"63",
+	"65",
+	"21284175091214687912771199898307297748211672914763848041968395774954376176754",
+	"6084766654921918907427900243509372380954290099172559290432744450051395395951",
+	"84594350493221918389213352992032324280367711247940675652888030554255915464401",
+	"82793403787388584738507275144194252681",
+	"115792089237316195423570985008687907853269984665640564039457584007913129639935", // 2^256-1
+
+	// Arnault, "Rabin-Miller Primality Test: Composite Numbers Which Pass It",
+	// Mathematics of Computation, 64(209) (January 1995), pp. 335-361.
+	"1195068768795265792518361315725116351898245581", // strong pseudoprime to prime bases 2 through 29
+
+	// Extra-strong Lucas pseudoprimes. https://oeis.org/A217719
+	"989",
+	"3239",
+	"5777",
+	"10877",
+	"27971",
+	"29681",
+	"30739",
+	"31631",
+	"39059",
+	"72389",
+	"73919",
+	"75077",
+	"100127",
+	"113573",
+	"125249",
+	"137549",
+	"137801",
+	"153931",
+	"155819",
+	"161027",
+	"162133",
+	"189419",
+	"218321",
+	"231703",
+	"249331",
+	"370229",
+	"429479",
+	"430127",
+	"459191",
+	"473891",
+	"480689",
+	"600059",
+	"621781",
+	"632249",
+	"635627",
+
+	"3673744903",
+	"3281593591",
+	"2385076987",
+	"2738053141",
+	"2009621503",
+	"1502682721",
+	"255866131",
+	"117987841",
+	"587861",
+
+	"6368689",
+	"8725753",
+	"80579735209",
+	"105919633",
+
+	// Strong pseudoprimes to base 2. https://oeis.org/A001262
+	"2047",
+	"3277",
+	"4033",
+	"3215031751",
+	"3825123056546413051",
+}
+
+func mustDecimal(t *testing.T, s string) *Int {
+	t.Helper()
+	z, err := FromDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return z
+}
+
+func TestProbablyPrime(t *testing.T) {
+	nreps := 20
+	if testing.Short() {
+		nreps = 1
+	}
+	for i, s := range primes {
+		p := mustDecimal(t, s)
+		if !p.ProbablyPrime(nreps) || nreps != 1 && !p.ProbablyPrime(1) || !p.ProbablyPrime(0) {
+			t.Errorf("#%d prime found to be non-prime (%s)", i, s)
+		}
+	}
+	for i, s := range composites {
+		c := mustDecimal(t, s)
+		if c.ProbablyPrime(nreps) || nreps != 1 && c.ProbablyPrime(1) || c.ProbablyPrime(0) {
+			t.Errorf("#%d composite found to be prime (%s)", i, s)
+		}
+	}
+	// Squares of primes must not pass the Lucas test.
+	for _, s := range []string{"13756265695458089029", "4294967291", "67"} {
+		var c Int
+		c.Mul(mustDecimal(t, s), mustDecimal(t, s))
+		if c.ProbablyPrime(0) {
+			t.Errorf("square %v found to be prime", &c)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("ProbablyPrime(-1): expected panic")
+		}
+	}()
+	NewInt(7).ProbablyPrime(-1)
+}
+
+func TestRandomProbablyPrime(t *testing.T) {
+	for i := 0; i < 20000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		x[0] |= 1
+		for _, n := range []int{0, 1} {
+			if have, want := x.ProbablyPrime(n), x.ToBig().ProbablyPrime(n); have != want {
+				t.Fatalf("ProbablyPrime(%v, %d): have %v want %v", x, n, have, want)
+			}
+		}
+	}
+	// Every small value, to cover the trial division and the Lucas
+	// parameter search.
+	for i := uint64(0); i < 100000; i++ {
+		x := NewInt(i)
+		if have, want := x.ProbablyPrime(0), x.ToBig().ProbablyPrime(0); have != want {
+			t.Fatalf("ProbablyPrime(%d, 0): have %v want %v", i, have, want)
+		}
+	}
+}
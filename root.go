@@ -0,0 +1,162 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math"
+	"math/bits"
+)
+
+// float64 returns an approximation of x as a float64, with a relative error
+// below 2^-52.
+func (x *Int) float64() float64 {
+	n := x.BitLen()
+	if n <= 64 {
+		return float64(x[0])
+	}
+	var t Int
+	s := uint(n - 64)
+	t.Rsh(x, s)
+	return math.Ldexp(float64(t[0]), int(s))
+}
+
+// rootEstimate sets z to an overestimate of x^(1/n), with a relative error
+// below 2^-40, for n >= 2. Both the rounding of x and the error of math.Pow
+// are far below the margin that is added.
+func (z *Int) rootEstimate(x *Int, n uint) *Int {
+	var f float64
+	if n == 2 {
+		f = math.Sqrt(x.float64())
+	} else {
+		f = math.Pow(x.float64(), 1/float64(n))
+	}
+	f = f*(1+0x1p-40) + 2
+	frac, exp := math.Frexp(f) // f = frac * 2^exp, with frac in [0.5, 1)
+	z.SetUint64(uint64(frac * 0x1p64))
+	if exp >= 64 {
+		return z.Lsh(z, uint(exp-64))
+	}
+	return z.Rsh(z, uint(64-exp))
+}
+
+// SqrtRem sets z to ⌊√x⌋ and r to x - z², and returns the pair (z, r).
+func (z *Int) SqrtRem(x, r *Int) (*Int, *Int) {
+	var root, sq Int
+	root.Sqrt(x)
+	sq.Mul(&root, &root)
+	r.Sub(x, &sq)
+	return z.Set(&root), r
+}
+
+// SqrtCeil sets z to ⌈√x⌉, the smallest integer such that z² ≥ x, and returns z.
+func (z *Int) SqrtCeil(x *Int) *Int {
+	var root, sq Int
+	root.Sqrt(x)
+	if sq.Mul(&root, &root).Eq(x) {
+		return z.Set(&root)
+	}
+	return z.AddUint64(&root, 1)
+}
+
+// squareResidues holds, for each of the moduli 64, 63, 11, 13 and 17, a bit
+// mask of the residues of squares.
+var squareResidues = func() (t [5]uint64) {
+	for i, m := range squareModuli {
+		for j := uint64(0); j < m; j++ {
+			t[i] |= 1 << (j * j % m)
+		}
+	}
+	return t
+}()
+
+var squareModuli = [5]uint64{64, 63, 11, 13, 17}
+
+// IsSquare reports whether z is a perfect square.
+func (z *Int) IsSquare() bool {
+	// Most non-squares are rejected by their residues, without the
+	// square root.
+	if squareResidues[0]&(1<<(z[0]&63)) == 0 {
+		return false
+	}
+	const m = 63 * 11 * 13 * 17
+	var r uint64
+	for i := 3; i >= 0; i-- {
+		r = bits.Rem64(r, z[i], m)
+	}
+	for i := 1; i < len(squareModuli); i++ {
+		if squareResidues[i]&(1<<(r%squareModuli[i])) == 0 {
+			return false
+		}
+	}
+	var root Int
+	root.Sqrt(z)
+	return root.Mul(&root, &root).Eq(z)
+}
+
+// Cbrt sets z to ⌊∛x⌋, the largest integer such that z³ ≤ x, and returns z.
+func (z *Int) Cbrt(x *Int) *Int {
+	return z.Root(x, 3)
+}
+
+// Root sets z to ⌊x^(1/n)⌋, the largest integer such that zⁿ ≤ x, and
+// returns z. It panics if n == 0.
+func (z *Int) Root(x *Int, n uint) *Int {
+	switch {
+	case n == 0:
+		panic("uint256: zeroth root")
+	case n == 1 || x.LtUint64(2):
+		return z.Set(x)
+	case n == 2:
+		return z.Sqrt(x)
+	case n >= uint(x.BitLen()):
+		// x < 2^n, so the root is 1.
+		return z.SetOne()
+	}
+	// Newton iteration "y = ⌊((n-1)y + ⌊x/y^(n-1)⌋)/n⌋" decreases for
+	// any y above the root, and stops decreasing at the root. The estimate
+	// is never below the root, so only one or two steps are needed to reach
+	// it, and one more to confirm it.
+	var (
+		y, y1, t Int
+		nm1      = NewInt(uint64(n - 1))
+		nn       = NewInt(uint64(n))
+	)
+	y.rootEstimate(x, n)
+	for {
+		if _, overflow := t.expOverflow(&y, n-1); overflow {
+			t.Clear() // y^(n-1) > x
+		} else {
+			t.Div(x, &t)
+		}
+		y1.Mul(&y, nm1)
+		y1.Add(&y1, &t)
+		y1.Div(&y1, nn)
+		if !y1.Lt(&y) {
+			return z.Set(&y)
+		}
+		y.Set(&y1)
+	}
+}
+
+// expOverflow sets z to base^exponent mod 2^256, and returns z and whether
+// the result overflowed 256 bits.
+func (z *Int) expOverflow(base *Int, exponent uint) (*Int, bool) {
+	var (
+		res      = Int{1}
+		b        = *base
+		overflow bool
+	)
+	// Left-to-right, all intermediate values are at most the result, so an
+	// overflow of any of them means that the result overflows.
+	for i := bits.Len(exponent) - 1; i >= 0; i-- {
+		_, sqrOverflow := res.MulOverflow(&res, &res)
+		overflow = overflow || sqrOverflow
+		if exponent>>uint(i)&1 == 1 {
+			_, mulOverflow := res.MulOverflow(&res, &b)
+			overflow = overflow || mulOverflow
+		}
+	}
+	return z.Set(&res), overflow
+}
@@ -0,0 +1,142 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+// bigRoot returns ⌊x^(1/n)⌋, determined bit by bit.
+func bigRoot(x *big.Int, n uint) *big.Int {
+	var (
+		r  = new(big.Int)
+		t  = new(big.Int)
+		bn = big.NewInt(int64(n))
+	)
+	for i := x.BitLen()/int(n) + 1; i >= 0; i-- {
+		t.SetBit(r, i, 1)
+		if new(big.Int).Exp(t, bn, nil).Cmp(x) <= 0 {
+			r.Set(t)
+		}
+	}
+	return r
+}
+
+// testRoots checks all the root functions on x against big.Int.
+func testRoots(t *testing.T, x *Int) {
+	t.Helper()
+	b := x.ToBig()
+	// Sqrt and variants
+	wantSqrt := new(big.Int).Sqrt(b)
+	if z := new(Int).Sqrt(x); !checkEq(wantSqrt, z) {
+		t.Fatalf("Sqrt(%#x): have %#x want %#x", x, z, wantSqrt)
+	}
+	wantRem := new(big.Int).Sub(b, new(big.Int).Mul(wantSqrt, wantSqrt))
+	z, r := new(Int), new(Int)
+	if res, rem := z.SqrtRem(x, r); res != z || rem != r || !checkEq(wantSqrt, z) || !checkEq(wantRem, r) {
+		t.Fatalf("SqrtRem(%#x): have %#x, %#x want %#x, %#x", x, z, r, wantSqrt, wantRem)
+	}
+	wantCeil := new(big.Int).Set(wantSqrt)
+	if wantRem.Sign() != 0 {
+		wantCeil.Add(wantCeil, big.NewInt(1))
+	}
+	if z := new(Int).SqrtCeil(x); !checkEq(wantCeil, z) {
+		t.Fatalf("SqrtCeil(%#x): have %#x want %#x", x, z, wantCeil)
+	}
+	if have, want := x.IsSquare(), wantRem.Sign() == 0; have != want {
+		t.Fatalf("IsSquare(%#x): have %v want %v", x, have, want)
+	}
+	// Aliasing
+	if y := x.Clone(); !y.SqrtCeil(y).Eq(new(Int).SqrtCeil(x)) {
+		t.Fatalf("SqrtCeil(%#x) with z == x: have %#x", x, y)
+	}
+	if y := x.Clone(); !y.Root(y, 3).Eq(new(Int).Root(x, 3)) {
+		t.Fatalf("Root(%#x, 3) with z == x: have %#x", x, y)
+	}
+	if y := x.Clone(); !y.Sqrt(y).Eq(new(Int).Sqrt(x)) {
+		t.Fatalf("Sqrt(%#x) with z == x: have %#x", x, y)
+	}
+	y, r := x.Clone(), new(Int)
+	if y.SqrtRem(y, r); !checkEq(wantSqrt, y) || !checkEq(wantRem, r) {
+		t.Fatalf("SqrtRem(%#x) with z == x: have %#x, %#x", x, y, r)
+	}
+	y, r = new(Int), x.Clone()
+	if y.SqrtRem(r, r); !checkEq(wantSqrt, y) || !checkEq(wantRem, r) {
+		t.Fatalf("SqrtRem(%#x) with r == x: have %#x, %#x", x, y, r)
+	}
+	// Cbrt and Root
+	if z, want := new(Int).Cbrt(x), bigRoot(b, 3); !checkEq(want, z) {
+		t.Fatalf("Cbrt(%#x): have %#x want %#x", x, z, want)
+	}
+	for _, n := range []uint{1, 2, 4, 5, 7, 16, 31, 64, 100, 255, 256, 1000} {
+		if z, want := new(Int).Root(x, n), bigRoot(b, n); !checkEq(want, z) {
+			t.Fatalf("Root(%#x, %d): have %#x want %#x", x, n, z, want)
+		}
+	}
+}
+
+func TestRandomRoots(t *testing.T) {
+	for i := 0; i < 5000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testRoots(t, x)
+		// Squares and their neighbours.
+		var r Int
+		r.Rsh(x, 128)
+		if i&1 == 1 {
+			r.Rsh(&r, uint(i%128))
+		}
+		sq := new(Int).Mul(&r, &r)
+		testRoots(t, sq)
+		testRoots(t, new(Int).AddUint64(sq, 1))
+		testRoots(t, new(Int).SubUint64(sq, 1))
+	}
+}
+
+func TestRoots(t *testing.T) {
+	values := []*Int{
+		new(Int),
+		new(Int).SetAllOne(),
+		new(Int).Lsh(NewInt(1), 255),
+		NewInt(1<<64 - 1),
+	}
+	// Squares of the largest roots.
+	for _, r := range []*Int{new(Int).SubUint64(new(Int).Lsh(NewInt(1), 128), 1), NewInt(1<<32 - 1), NewInt(1 << 32)} {
+		values = append(values, new(Int).Mul(r, r))
+	}
+	for i := uint64(0); i < 2000; i++ {
+		values = append(values, NewInt(i))
+	}
+	for i := uint(0); i < 256; i++ {
+		p := new(Int).Lsh(NewInt(1), i)
+		values = append(values, p, new(Int).AddUint64(p, 1), new(Int).SubUint64(p, 1))
+	}
+	for _, x := range values {
+		testRoots(t, x)
+	}
+	// Every power for small bases, and its neighbours.
+	for n := uint(3); n <= 256; n++ {
+		for _, base := range []uint64{2, 3, 7, 10, 255, 1<<21 - 1, 1 << 40, 1<<64 - 1} {
+			var p Int
+			if _, overflow := p.expOverflow(NewInt(base), n); overflow {
+				continue
+			}
+			for _, x := range []*Int{&p, new(Int).AddUint64(&p, 1), new(Int).SubUint64(&p, 1)} {
+				if z, want := new(Int).Root(x, n), bigRoot(x.ToBig(), n); !checkEq(want, z) {
+					t.Fatalf("Root(%#x, %d): have %#x want %#x", x, n, z, want)
+				}
+			}
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Root(x, 0): expected panic")
+		}
+	}()
+	new(Int).Root(NewInt(7), 0)
+}
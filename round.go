@@ -0,0 +1,93 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+// RoundingMode determines how the quotient of a division is rounded to an
+// integer.
+type RoundingMode byte
+
+const (
+	RoundDown     RoundingMode = iota // towards zero, as Div
+	RoundUp                           // away from zero, as DivCeil
+	RoundHalfUp                       // to nearest, ties away from zero
+	RoundHalfDown                     // to nearest, ties towards zero
+	RoundHalfEven                     // to nearest, ties to even
+)
+
+// roundsUp reports whether the quotient q with the remainder r < d must be
+// incremented to round it according to mode.
+func (mode RoundingMode) roundsUp(q, r, d *Int) bool {
+	if mode > RoundHalfEven {
+		panic("uint256: unknown rounding mode")
+	}
+	if r.IsZero() || mode == RoundDown {
+		return false
+	}
+	if mode == RoundUp {
+		return true
+	}
+	// Compare r to d-r, rather than 2r, which may overflow, to d.
+	var dr Int
+	half := r.Cmp(dr.Sub(d, r))
+	switch mode {
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	}
+	return half > 0 || (half == 0 && q[0]&1 == 1)
+}
+
+// DivCeil sets z to the quotient x/y rounded up, and returns z.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) DivCeil(x, y *Int) *Int {
+	return z.DivRound(x, y, RoundUp)
+}
+
+// DivRound sets z to the quotient x/y rounded according to mode, and
+// returns z. It panics for an unknown mode.
+// If y == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) DivRound(x, y *Int, mode RoundingMode) *Int {
+	if y.IsZero() {
+		return z.Clear()
+	}
+	var (
+		quot Int
+		rem  = udivrem(quot[:], x[:], y)
+	)
+	if mode.roundsUp(&quot, &rem, y) {
+		// Cannot overflow: a non-zero remainder implies y > 1.
+		quot.AddUint64(&quot, 1)
+	}
+	return z.Set(&quot)
+}
+
+// MulDivRoundOverflow calculates (x*y)/d with full precision, rounded
+// according to mode, and returns z and whether overflow occurred (the result
+// does not fit to 256-bit). With RoundUp it matches the Uniswap
+// FullMath.mulDivRoundingUp. It panics for an unknown mode.
+// If d == 0, z is set to 0
+func (z *Int) MulDivRoundOverflow(x, y, d *Int, mode RoundingMode) (*Int, bool) {
+	if x.IsZero() || y.IsZero() || d.IsZero() {
+		return z.Clear(), false
+	}
+	p := umul(x, y)
+
+	var quot [8]uint64
+	rem := udivrem(quot[:], p[:], d)
+	if mode.roundsUp((*Int)(quot[:4]), &rem, d) {
+		// Cannot overflow 512 bits: a non-zero remainder implies d > 1.
+		for i := range quot {
+			quot[i]++
+			if quot[i] != 0 {
+				break
+			}
+		}
+	}
+
+	copy(z[:], quot[:4])
+
+	return z, (quot[4] | quot[5] | quot[6] | quot[7]) != 0
+}
@@ -0,0 +1,200 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"math/big"
+	"testing"
+)
+
+var roundingModes = []RoundingMode{RoundDown, RoundUp, RoundHalfUp, RoundHalfDown, RoundHalfEven}
+
+// bigDivRound returns x/y rounded according to mode, or 0 if y == 0.
+func bigDivRound(x, y *big.Int, mode RoundingMode) *big.Int {
+	if y.Sign() == 0 {
+		return new(big.Int)
+	}
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	half := new(big.Int).Lsh(r, 1).Cmp(y)
+	var up bool
+	switch mode {
+	case RoundUp:
+		up = true
+	case RoundHalfUp:
+		up = half >= 0
+	case RoundHalfDown:
+		up = half > 0
+	case RoundHalfEven:
+		up = half > 0 || (half == 0 && q.Bit(0) == 1)
+	}
+	if up {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+func testDivRound(t *testing.T, x, y *Int) {
+	t.Helper()
+	for _, mode := range roundingModes {
+		want := bigDivRound(x.ToBig(), y.ToBig(), mode)
+		if z := new(Int).DivRound(x, y, mode); !checkEq(want, z) {
+			t.Fatalf("DivRound(%#x, %#x, %d): have %#x want %#x", x, y, mode, z, want)
+		}
+		if z := x.Clone(); !checkEq(want, z.DivRound(z, y, mode)) {
+			t.Fatalf("DivRound(%#x, %#x, %d) with z == x: have %#x want %#x", x, y, mode, z, want)
+		}
+		if z := y.Clone(); !checkEq(want, z.DivRound(x, z, mode)) {
+			t.Fatalf("DivRound(%#x, %#x, %d) with z == y: have %#x want %#x", x, y, mode, z, want)
+		}
+	}
+	if z, want := new(Int).DivCeil(x, y), new(Int).DivRound(x, y, RoundUp); !z.Eq(want) {
+		t.Fatalf("DivCeil(%#x, %#x): have %#x want %#x", x, y, z, want)
+	}
+}
+
+func testMulDivRound(t *testing.T, x, y, d *Int) {
+	t.Helper()
+	p := new(big.Int).Mul(x.ToBig(), y.ToBig())
+	for _, mode := range roundingModes {
+		want := bigDivRound(p, d.ToBig(), mode)
+		wantOverflow := want.Cmp(tt256m1) > 0
+		z := new(Int)
+		if _, overflow := z.MulDivRoundOverflow(x, y, d, mode); !checkEq(want, z) || overflow != wantOverflow {
+			t.Fatalf("MulDivRoundOverflow(%#x, %#x, %#x, %d): have %#x, %v want %#x, %v", x, y, d, mode, z, overflow, u256(want), wantOverflow)
+		}
+		z = d.Clone()
+		if _, overflow := z.MulDivRoundOverflow(x, y, z, mode); !checkEq(want, z) || overflow != wantOverflow {
+			t.Fatalf("MulDivRoundOverflow(%#x, %#x, %#x, %d) with z == d: have %#x, %v want %#x, %v", x, y, d, mode, z, overflow, u256(want), wantOverflow)
+		}
+	}
+}
+
+func TestDivRound(t *testing.T) {
+	// Each tie, and the values around it, for every mode.
+	for x := uint64(0); x < 64; x++ {
+		for y := uint64(0); y < 16; y++ {
+			testDivRound(t, NewInt(x), NewInt(y))
+		}
+	}
+	for _, tc := range []struct {
+		x, y string
+		want [5]uint64 // Down, Up, HalfUp, HalfDown, HalfEven
+	}{
+		{"1", "2", [5]uint64{0, 1, 1, 0, 0}},
+		{"3", "2", [5]uint64{1, 2, 2, 1, 2}},
+		{"5", "2", [5]uint64{2, 3, 3, 2, 2}},
+		{"7", "2", [5]uint64{3, 4, 4, 3, 4}},
+		{"5", "4", [5]uint64{1, 2, 1, 1, 1}},
+		{"7", "4", [5]uint64{1, 2, 2, 2, 2}},
+		{"25", "10", [5]uint64{2, 3, 3, 2, 2}},
+		{"35", "10", [5]uint64{3, 4, 4, 3, 4}},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", [5]uint64{1, 1, 1, 1, 1}},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe", [5]uint64{1, 2, 1, 1, 1}},
+		{"0xc000000000000000000000000000000000000000000000000000000000000000", "0x8000000000000000000000000000000000000000000000000000000000000000", [5]uint64{1, 2, 2, 1, 2}},
+		{"0x8000000000000000000000000000000000000000000000000000000000000000", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", [5]uint64{0, 1, 1, 1, 1}},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", [5]uint64{0, 1, 0, 0, 0}},
+	} {
+		b1, _ := new(big.Int).SetString(tc.x, 0)
+		b2, _ := new(big.Int).SetString(tc.y, 0)
+		x, _ := FromBig(b1)
+		y, _ := FromBig(b2)
+		for i, mode := range roundingModes {
+			if z := new(Int).DivRound(x, y, mode); !z.EqUint64(tc.want[i]) {
+				t.Errorf("DivRound(%s, %s, %d): have %#x want %d", tc.x, tc.y, mode, z, tc.want[i])
+			}
+		}
+		testDivRound(t, x, y)
+	}
+	for _, tc := range binTestCases {
+		b1, _ := new(big.Int).SetString(tc[0], 0)
+		b2, _ := new(big.Int).SetString(tc[1], 0)
+		x, _ := FromBig(b1)
+		y, _ := FromBig(b2)
+		testDivRound(t, x, y)
+		// Make the remainder exactly half of the divisor d = 2y.
+		d := new(Int).Lsh(y, 1)
+		if d.IsZero() {
+			continue
+		}
+		tie, overflow := new(Int).MulOverflow(new(Int).Div(x, d), d)
+		if _, carry := tie.AddOverflow(tie, y); !overflow && !carry {
+			testDivRound(t, tie, d)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, y, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testDivRound(t, x, y)
+		testDivRound(t, x, new(Int).Rsh(y, uint(x[0]%256)))
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("DivRound(3, 2, RoundHalfEven+1): expected panic")
+		}
+	}()
+	new(Int).DivRound(NewInt(3), NewInt(2), RoundHalfEven+1)
+}
+
+func TestMulDivRoundOverflow(t *testing.T) {
+	for x := uint64(0); x < 16; x++ {
+		for y := uint64(0); y < 8; y++ {
+			for d := uint64(0); d < 8; d++ {
+				testMulDivRound(t, NewInt(x), NewInt(y), NewInt(d))
+			}
+		}
+	}
+	var (
+		max       = new(Int).SetAllOne()
+		two128    = new(Int).Lsh(NewInt(1), 128)
+		two255    = new(Int).Lsh(NewInt(1), 255)
+		two255m1  = new(Int).SubUint64(two255, 1)
+		maxMinus1 = new(Int).SubUint64(max, 1)
+	)
+	// (2^256-1) * 2 / 4 = 2^255 - 1/2, the tie rounding to the even 2^255.
+	testMulDivRound(t, max, NewInt(2), NewInt(4))
+	// (2^256-1) * 3 / 2 = 3*2^255 - 3/2, a tie which overflows either way.
+	testMulDivRound(t, max, NewInt(3), NewInt(2))
+	// (2^256-2) * (2^255+2) / (2^255+1) = 2^256-1 + (2^255-3)/(2^255+1),
+	// which overflows only when rounded up.
+	testMulDivRound(t, maxMinus1, new(Int).AddUint64(two255, 2), new(Int).AddUint64(two255, 1))
+	testMulDivRound(t, max, two255, max)
+	testMulDivRound(t, max, max, maxMinus1)
+	testMulDivRound(t, max, max, max)
+	testMulDivRound(t, two128, two128, NewInt(1))
+	testMulDivRound(t, two128, two128, NewInt(2))
+	testMulDivRound(t, two255m1, NewInt(2), NewInt(2))
+	testMulDivRound(t, two255m1, NewInt(3), NewInt(6))
+	for i := 0; i < 1000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, y, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		_, d, err := randNums()
+		if err != nil {
+			t.Fatalf("Error getting a random number: %v", err)
+		}
+		testMulDivRound(t, x, y, d)
+		testMulDivRound(t, x, NewInt(2), NewInt(4))
+		// d even, and x*y an odd multiple of d/2, to hit the tie.
+		d.SetBit(d, 0, 0)
+		if d.IsZero() {
+			continue
+		}
+		testMulDivRound(t, new(Int).Rsh(d, 1), y.SetBit(y, 0, 1), d)
+	}
+}
@@ -0,0 +1,152 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "math/bits"
+
+// AddSat sets z to the sum x+y, clamped to 2^256-1 on overflow, and returns z.
+func (z *Int) AddSat(x, y *Int) *Int {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	m := -carry // all ones on overflow
+	z[0], z[1], z[2], z[3] = z[0]|m, z[1]|m, z[2]|m, z[3]|m
+	return z
+}
+
+// SubSat sets z to the difference x-y, clamped to 0 on underflow, and returns z.
+func (z *Int) SubSat(x, y *Int) *Int {
+	var borrow uint64
+	z[0], borrow = bits.Sub64(x[0], y[0], 0)
+	z[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	z[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	z[3], borrow = bits.Sub64(x[3], y[3], borrow)
+	m := borrow - 1 // all zeros on underflow
+	z[0], z[1], z[2], z[3] = z[0]&m, z[1]&m, z[2]&m, z[3]&m
+	return z
+}
+
+// MulSat sets z to the product x*y, clamped to 2^256-1 on overflow, and returns z.
+func (z *Int) MulSat(x, y *Int) *Int {
+	p := umul(x, y)
+	m := nonZeroMask(p[4] | p[5] | p[6] | p[7]) // all ones on overflow
+	z[0], z[1], z[2], z[3] = p[0]|m, p[1]|m, p[2]|m, p[3]|m
+	return z
+}
+
+// LshSat sets z = x << n, clamped to 2^256-1 if any set bit is shifted
+// out, and returns z.
+func (z *Int) LshSat(x *Int, n uint) *Int {
+	// Bit j of x is shifted out if j >= 256-n. The shift is clamped to 256
+	// with a select, so that the threshold of each word, 256-n-64*i, lies in
+	// [-256, 256]. Word bits at or above it are lost, all of them when it is
+	// negative (clamped to 0 below), none when it is 64 or more (a shift of
+	// 64 or more gives 0).
+	large := nonZeroMask(uint64(n) >> 8)
+	s := int64(256 - (uint64(n)&^large | 256&large))
+	var lost uint64
+	for i := range x {
+		t := s - int64(64*i)
+		t &^= t >> 63
+		lost |= x[i] & (^uint64(0) << uint64(t))
+	}
+	m := nonZeroMask(lost) // all ones on overflow
+
+	// Shift by n%64 within the words, then select the words to move by
+	// n/64 with masks. No mask matches for n >= 256, leaving 0.
+	var (
+		r = uint64(n) & 63
+		q = uint64(n) >> 6
+		w [4]uint64
+	)
+	w[0] = x[0] << r
+	w[1] = x[1]<<r | x[0]>>(64-r)
+	w[2] = x[2]<<r | x[1]>>(64-r)
+	w[3] = x[3]<<r | x[2]>>(64-r)
+	q0, q1, q2, q3 := ^nonZeroMask(q), ^nonZeroMask(q^1), ^nonZeroMask(q^2), ^nonZeroMask(q^3)
+	z[3] = w[3]&q0 | w[2]&q1 | w[1]&q2 | w[0]&q3 | m
+	z[2] = w[2]&q0 | w[1]&q1 | w[0]&q2 | m
+	z[1] = w[1]&q0 | w[0]&q1 | m
+	z[0] = w[0]&q0 | m
+	return z
+}
+
+// ExpSat sets z = base**exponent, clamped to 2^256-1 on overflow, and
+// returns z. It does not branch on the operands, so it always takes 16
+// multiplications.
+func (z *Int) ExpSat(base, exponent *Int) *Int {
+	var (
+		res     = Int{1}
+		b       = *base
+		ov, bov uint64 // overflow masks of res and of b
+	)
+	// Right-to-left square-and-multiply over the low 8 bits of the exponent,
+	// always doing both products and selecting with masks. Once a square of
+	// the base overflows it stays flagged: any later multiplication by it
+	// overflows too, as the partial result is at least 1 for base != 0, and
+	// the squares of 0 never overflow.
+	for i := 0; i < 8; i++ {
+		bit := -(exponent[0] >> i & 1) // all ones if bit i is set
+		p := umul(&res, &b)
+		ov |= bit & (bov | nonZeroMask(p[4]|p[5]|p[6]|p[7]))
+		res[0], res[1] = res[0]&^bit|p[0]&bit, res[1]&^bit|p[1]&bit
+		res[2], res[3] = res[2]&^bit|p[2]&bit, res[3]&^bit|p[3]&bit
+		p = umul(&b, &b)
+		bov |= nonZeroMask(p[4] | p[5] | p[6] | p[7])
+		b = Int{p[0], p[1], p[2], p[3]}
+	}
+	// For exponents >= 256, 0**e == 0 and 1**e == 1, and anything larger
+	// overflows.
+	large := nonZeroMask(exponent[0]>>8 | exponent[1] | exponent[2] | exponent[3])
+	ov |= large & nonZeroMask(base[0]>>1|base[1]|base[2]|base[3])
+	z[0] = res[0]&^large | base[0]&large | ov
+	z[1] = res[1]&^large | base[1]&large | ov
+	z[2] = res[2]&^large | base[2]&large | ov
+	z[3] = res[3]&^large | base[3]&large | ov
+	return z
+}
+
+// nonZeroMask returns all ones if v != 0, and 0 otherwise.
+func nonZeroMask(v uint64) uint64 {
+	return -((v | -v) >> 63)
+}
+
+// SAddSat interprets x and y as two's complement signed integers, sets z to
+// the sum x+y, clamped to the range [-2^255, 2^255-1] on overflow, and
+// returns z.
+func (z *Int) SAddSat(x, y *Int) *Int {
+	xs, ys := x[3], y[3]
+	z.Add(x, y)
+	// Overflow iff x and y have the same sign, and the sum the other one.
+	m := -(((xs ^ z[3]) & (ys ^ z[3])) >> 63)
+	return z.signedSaturate(xs, m)
+}
+
+// SSubSat interprets x and y as two's complement signed integers, sets z to
+// the difference x-y, clamped to the range [-2^255, 2^255-1] on overflow, and
+// returns z.
+func (z *Int) SSubSat(x, y *Int) *Int {
+	xs, ys := x[3], y[3]
+	z.Sub(x, y)
+	// Overflow iff x and y have different signs, and the difference the
+	// sign of y.
+	m := -(((xs ^ ys) & (xs ^ z[3])) >> 63)
+	return z.signedSaturate(xs, m)
+}
+
+// signedSaturate replaces z with the signed bound in the direction of the
+// sign of xs (the most significant word of an operand), where m is all ones,
+// and returns z.
+func (z *Int) signedSaturate(xs, m uint64) *Int {
+	lo := (xs >> 63) - 1 // all ones for 2^255-1, zeros for -2^255
+	hi := lo ^ (1 << 63)
+	z[0] = z[0]&^m | lo&m
+	z[1] = z[1]&^m | lo&m
+	z[2] = z[2]&^m | lo&m
+	z[3] = z[3]&^m | hi&m
+	return z
+}
@@ -0,0 +1,181 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import "errors"
+
+var (
+	// ErrSlotLayout is returned for fields which overlap, do not fit in
+	// 256 bits, have zero width, or share a name.
+	ErrSlotLayout = errors.New("slot: invalid field layout")
+	// ErrSlotField is returned for a field name which is not in the layout.
+	ErrSlotField = errors.New("slot: unknown field")
+	// ErrSlotRange is returned for a value which does not fit its field.
+	ErrSlotRange = errors.New("slot: value out of field range")
+)
+
+// SlotField describes a field packed into a 256-bit storage slot.
+type SlotField struct {
+	Name   string
+	Offset uint // position of the least significant bit of the field
+	Width  uint // number of bits, 1 to 256
+	Signed bool // two's complement signed integer, as Solidity intN
+}
+
+// get sets z to the value of the field in slot, sign-extended to 256 bits
+// for signed fields, and returns z.
+func (f *SlotField) get(z, slot *Int) *Int {
+	z.ExtractBits(slot, f.Offset, f.Width)
+	if f.Signed {
+		z.extendSignBits(z, f.Width)
+	}
+	return z
+}
+
+// fits reports whether val can be stored in the field without loss. Signed
+// values are in two's complement form, as returned by (*Int256).Unsigned.
+func (f *SlotField) fits(val *Int) bool {
+	var t Int
+	t.ExtractBits(val, 0, f.Width)
+	if f.Signed {
+		t.extendSignBits(&t, f.Width)
+	}
+	return t.Eq(val)
+}
+
+// SlotLayout describes how named fields are packed into a single 256-bit
+// storage slot. The zero value has no fields.
+type SlotLayout struct {
+	fields []SlotField
+}
+
+// NewSlotLayout returns a layout of the given fields, at their given
+// offsets. The fields must have distinct names, non-zero widths, and fit the
+// slot without overlapping; otherwise ErrSlotLayout is returned.
+func NewSlotLayout(fields ...SlotField) (*SlotLayout, error) {
+	var used Int
+	for i, f := range fields {
+		if f.Width == 0 || f.Width > 256 || f.Offset > 256-f.Width {
+			return nil, ErrSlotLayout
+		}
+		var mask, overlap Int
+		mask.Mask(f.Width).Lsh(&mask, f.Offset)
+		if !overlap.And(&mask, &used).IsZero() {
+			return nil, ErrSlotLayout
+		}
+		used.Or(&used, &mask)
+		for _, g := range fields[:i] {
+			if g.Name == f.Name {
+				return nil, ErrSlotLayout
+			}
+		}
+	}
+	return &SlotLayout{fields: append([]SlotField(nil), fields...)}, nil
+}
+
+// NewPackedSlotLayout returns a layout of the given fields, ignoring their
+// Offset, and placing them one after another starting at the least
+// significant bit. This is how Solidity packs consecutive state variables
+// that fit in one slot, e.g. uint8, bool (8 bits), address (160 bits) and
+// intN/uintN (N bits).
+func NewPackedSlotLayout(fields ...SlotField) (*SlotLayout, error) {
+	var (
+		packed = make([]SlotField, len(fields))
+		offset uint
+	)
+	for i, f := range fields {
+		if f.Width > 256-offset {
+			return nil, ErrSlotLayout
+		}
+		f.Offset = offset
+		packed[i] = f
+		offset += f.Width
+	}
+	return NewSlotLayout(packed...)
+}
+
+// Fields returns the fields of the layout.
+func (l *SlotLayout) Fields() []SlotField {
+	return append([]SlotField(nil), l.fields...)
+}
+
+// Field returns the field with the given name, and whether it exists.
+func (l *SlotLayout) Field(name string) (SlotField, bool) {
+	if f := l.field(name); f != nil {
+		return *f, true
+	}
+	return SlotField{}, false
+}
+
+func (l *SlotLayout) field(name string) *SlotField {
+	for i := range l.fields {
+		if l.fields[i].Name == name {
+			return &l.fields[i]
+		}
+	}
+	return nil
+}
+
+// Get returns the value of the named field in slot. Signed fields are
+// sign-extended, so the result can be viewed as an Int256 with Signed.
+func (l *SlotLayout) Get(slot *Int, name string) (*Int, error) {
+	f := l.field(name)
+	if f == nil {
+		return nil, ErrSlotField
+	}
+	return f.get(new(Int), slot), nil
+}
+
+// Set stores val in the named field of slot, leaving the other bits of slot
+// untouched. Values of signed fields are in two's complement form. If val
+// does not fit the field, ErrSlotRange is returned and slot is unmodified.
+func (l *SlotLayout) Set(slot *Int, name string, val *Int) error {
+	f := l.field(name)
+	if f == nil {
+		return ErrSlotField
+	}
+	if !f.fits(val) {
+		return ErrSlotRange
+	}
+	slot.InsertBits(slot, val, f.Offset, f.Width)
+	return nil
+}
+
+// Unpack returns the values of all fields in slot, by name. Signed fields
+// are sign-extended, as with Get.
+func (l *SlotLayout) Unpack(slot *Int) map[string]*Int {
+	values := make(map[string]*Int, len(l.fields))
+	for i := range l.fields {
+		values[l.fields[i].Name] = l.fields[i].get(new(Int), slot)
+	}
+	return values
+}
+
+// Pack returns the slot holding the given values. Fields without a value
+// are zero. The values are checked in layout order: it returns ErrSlotRange
+// for the first value which does not fit its field, and otherwise
+// ErrSlotField if any name is not in the layout.
+func (l *SlotLayout) Pack(values map[string]*Int) (*Int, error) {
+	var (
+		slot  Int
+		found int
+	)
+	for i := range l.fields {
+		f := &l.fields[i]
+		val, ok := values[f.Name]
+		if !ok {
+			continue
+		}
+		if !f.fits(val) {
+			return nil, ErrSlotRange
+		}
+		slot.InsertBits(&slot, val, f.Offset, f.Width)
+		found++
+	}
+	if found != len(values) {
+		return nil, ErrSlotField
+	}
+	return &slot, nil
+}
@@ -0,0 +1,131 @@
+// uint256: Fixed size 256-bit math library
+// Copyright 2026 uint256 Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package uint256
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlotLayout(t *testing.T) {
+	// struct { uint8 a; bool b; address c; int32 d; uint48 e; }
+	layout, err := NewPackedSlotLayout(
+		SlotField{Name: "a", Width: 8},
+		SlotField{Name: "b", Width: 8},
+		SlotField{Name: "c", Width: 160},
+		SlotField{Name: "d", Width: 32, Signed: true},
+		SlotField{Name: "e", Width: 48},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOffsets := map[string]uint{"a": 0, "b": 8, "c": 16, "d": 176, "e": 208}
+	for _, f := range layout.Fields() {
+		if f.Offset != wantOffsets[f.Name] {
+			t.Errorf("field %s: have offset %d want %d", f.Name, f.Offset, wantOffsets[f.Name])
+		}
+	}
+	if f, ok := layout.Field("c"); !ok || f.Width != 160 {
+		t.Errorf("Field(c): have %+v, %v", f, ok)
+	}
+	if _, ok := layout.Field("x"); ok {
+		t.Errorf("Field(x): expected no field")
+	}
+
+	addr, _ := FromHex("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	values := map[string]*Int{
+		"a": NewInt(0x7f),
+		"b": NewInt(1),
+		"c": addr,
+		"d": NewInt256(-2).Unsigned(),
+		"e": NewInt(1<<48 - 1),
+	}
+	slot, err := layout.Pack(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := FromHex("0xfffffffffffffffffffedeadbeefdeadbeefdeadbeefdeadbeefdeadbeef017f")
+	if !slot.Eq(want) {
+		t.Fatalf("Pack: have %#x want %#x", slot, want)
+	}
+	for name, val := range layout.Unpack(slot) {
+		if !val.Eq(values[name]) {
+			t.Errorf("Unpack: field %s: have %#x want %#x", name, val, values[name])
+		}
+	}
+	if d, err := layout.Get(slot, "d"); err != nil || d.Signed().Int64() != -2 {
+		t.Errorf("Get(d): have %v, %v want -2", d, err)
+	}
+
+	// Set leaves the other fields untouched.
+	if err := layout.Set(slot, "d", NewInt(1<<31-1)); err != nil {
+		t.Fatal(err)
+	}
+	want.InsertBits(want, NewInt(1<<31-1), 176, 32)
+	if !slot.Eq(want) {
+		t.Fatalf("Set: have %#x want %#x", slot, want)
+	}
+
+	// Errors
+	orig := slot.Clone()
+	for _, tc := range []struct {
+		name string
+		val  *Int
+		err  error
+	}{
+		{"a", NewInt(256), ErrSlotRange},
+		{"d", NewInt(1 << 31), ErrSlotRange},
+		{"d", NewInt256(-1<<31 - 1).Unsigned(), ErrSlotRange},
+		{"c", new(Int).Lsh(NewInt(1), 160), ErrSlotRange},
+		{"x", NewInt(0), ErrSlotField},
+	} {
+		if err := layout.Set(slot, tc.name, tc.val); !errors.Is(err, tc.err) {
+			t.Errorf("Set(%s, %#x): have error %v want %v", tc.name, tc.val, err, tc.err)
+		}
+		if _, err := layout.Pack(map[string]*Int{tc.name: tc.val}); !errors.Is(err, tc.err) {
+			t.Errorf("Pack(%s, %#x): have error %v want %v", tc.name, tc.val, err, tc.err)
+		}
+	}
+	if !slot.Eq(orig) {
+		t.Fatalf("slot modified by failing Set: have %#x want %#x", slot, orig)
+	}
+	// With several invalid values, the error does not depend on map order:
+	// range errors come first, in layout order, then unknown fields.
+	invalid := map[string]*Int{"x": NewInt(0), "d": NewInt(1 << 31), "a": NewInt(256), "y": NewInt(0)}
+	for i := 0; i < 20; i++ {
+		if _, err := layout.Pack(invalid); !errors.Is(err, ErrSlotRange) {
+			t.Fatalf("Pack(%v): have error %v want %v", invalid, err, ErrSlotRange)
+		}
+		if _, err := layout.Pack(map[string]*Int{"x": NewInt(0), "a": NewInt(1)}); !errors.Is(err, ErrSlotField) {
+			t.Fatalf("Pack(x, a): have error %v want %v", err, ErrSlotField)
+		}
+	}
+	if err := layout.Set(slot, "d", NewInt256(-1<<31).Unsigned()); err != nil {
+		t.Errorf("Set(d, -2^31): have error %v", err)
+	}
+	if _, err := layout.Get(slot, "x"); !errors.Is(err, ErrSlotField) {
+		t.Errorf("Get(x): have error %v want %v", err, ErrSlotField)
+	}
+}
+
+func TestSlotLayoutInvalid(t *testing.T) {
+	for i, fields := range [][]SlotField{
+		{{Name: "a", Width: 0}},
+		{{Name: "a", Width: 257}},
+		{{Name: "a", Offset: 250, Width: 8}},
+		{{Name: "a", Width: 8}, {Name: "b", Offset: 7, Width: 8}},
+		{{Name: "a", Width: 8}, {Name: "a", Offset: 8, Width: 8}},
+	} {
+		if _, err := NewSlotLayout(fields...); !errors.Is(err, ErrSlotLayout) {
+			t.Errorf("#%d: have error %v want %v", i, err, ErrSlotLayout)
+		}
+	}
+	if _, err := NewPackedSlotLayout(SlotField{Name: "a", Width: 160}, SlotField{Name: "b", Width: 160}); !errors.Is(err, ErrSlotLayout) {
+		t.Errorf("packed overflow: have error %v want %v", err, ErrSlotLayout)
+	}
+	if _, err := NewSlotLayout(SlotField{Name: "a", Width: 256}); err != nil {
+		t.Errorf("full slot: have error %v", err)
+	}
+}
@@ -266,6 +266,47 @@ func (z *Int) AddMod(x, y, m *Int) *Int {
 	return z.Mod(z, m)
 }
 
+// SubMod sets z to the difference ( x-y ) mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) SubMod(x, y, m *Int) *Int {
+	if m.IsZero() {
+		return z.Clear()
+	}
+	var (
+		a, b, d       Int
+		borrow, carry uint64
+	)
+	a.Mod(x, m)
+	b.Mod(y, m)
+
+	d[0], borrow = bits.Sub64(a[0], b[0], 0)
+	d[1], borrow = bits.Sub64(a[1], b[1], borrow)
+	d[2], borrow = bits.Sub64(a[2], b[2], borrow)
+	d[3], borrow = bits.Sub64(a[3], b[3], borrow)
+
+	// a < b, so add m to the wrapped difference
+	if borrow != 0 {
+		d[0], carry = bits.Add64(d[0], m[0], 0)
+		d[1], carry = bits.Add64(d[1], m[1], carry)
+		d[2], carry = bits.Add64(d[2], m[2], carry)
+		d[3], _ = bits.Add64(d[3], m[3], carry)
+	}
+	return z.Set(&d)
+}
+
+// NegMod sets z to -x mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) NegMod(x, m *Int) *Int {
+	if m.IsZero() {
+		return z.Clear()
+	}
+	var r Int
+	if r.Mod(x, m).IsZero() {
+		return z.Clear()
+	}
+	return z.Sub(m, &r)
+}
+
 // AddUint64 sets z to x + y, where y is a uint64, and returns z
 func (z *Int) AddUint64(x *Int, y uint64) *Int {
 	var carry uint64
@@ -277,6 +318,17 @@ func (z *Int) AddUint64(x *Int, y uint64) *Int {
 	return z
 }
 
+// AddUint64Overflow sets z to x + y, where y is a uint64, and returns z and
+// whether overflow occurred.
+func (z *Int) AddUint64Overflow(x *Int, y uint64) (*Int, bool) {
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y, 0)
+	z[1], carry = bits.Add64(x[1], 0, carry)
+	z[2], carry = bits.Add64(x[2], 0, carry)
+	z[3], carry = bits.Add64(x[3], 0, carry)
+	return z, carry != 0
+}
+
 // PaddedBytes encodes a Int as a 0-padded byte slice. The length
 // of the slice is at least n bytes.
 // Example, z =1, n = 20 => [0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 1]
@@ -299,6 +351,17 @@ func (z *Int) SubUint64(x *Int, y uint64) *Int {
 	return z
 }
 
+// SubUint64Overflow sets z to the difference x - y, where y is a uint64,
+// and returns z and true if the operation underflowed.
+func (z *Int) SubUint64Overflow(x *Int, y uint64) (*Int, bool) {
+	var carry uint64
+	z[0], carry = bits.Sub64(x[0], y, 0)
+	z[1], carry = bits.Sub64(x[1], 0, carry)
+	z[2], carry = bits.Sub64(x[2], 0, carry)
+	z[3], carry = bits.Sub64(x[3], 0, carry)
+	return z, carry != 0
+}
+
 // SubOverflow sets z to the difference x-y and returns z and true if the operation underflowed
 func (z *Int) SubOverflow(x, y *Int) (*Int, bool) {
 	var carry uint64
@@ -337,6 +400,16 @@ func umulHop(z, x, y uint64) (hi, lo uint64) {
 	return hi, lo
 }
 
+// mulAddUint64 computes z = z * m + a, and returns the carry-out word.
+func (z *Int) mulAddUint64(m, a uint64) uint64 {
+	carry, z0 := umulHop(a, z[0], m)
+	carry, z1 := umulHop(carry, z[1], m)
+	carry, z2 := umulHop(carry, z[2], m)
+	carry, z3 := umulHop(carry, z[3], m)
+	z[0], z[1], z[2], z[3] = z0, z1, z2, z3
+	return carry
+}
+
 // umul computes full 256 x 256 -> 512 multiplication.
 func umul(x, y *Int) [8]uint64 {
 	var (
@@ -393,6 +466,13 @@ func (z *Int) Mul(x, y *Int) *Int {
 	return z.Set(&res)
 }
 
+// MulUint64 sets z to the product x*y, where y is a uint64, and returns z.
+func (z *Int) MulUint64(x *Int, y uint64) *Int {
+	z.Set(x)
+	z.mulAddUint64(y, 0)
+	return z
+}
+
 // MulOverflow sets z to the product x*y, and returns z and  whether overflow occurred
 func (z *Int) MulOverflow(x, y *Int) (*Int, bool) {
 	p := umul(x, y)
@@ -400,6 +480,59 @@ func (z *Int) MulOverflow(x, y *Int) (*Int, bool) {
 	return z, (p[4] | p[5] | p[6] | p[7]) != 0
 }
 
+// MulUint64Overflow sets z to the product x*y, where y is a uint64, and
+// returns z and whether overflow occurred.
+func (z *Int) MulUint64Overflow(x *Int, y uint64) (*Int, bool) {
+	z.Set(x)
+	return z, z.mulAddUint64(y, 0) != 0
+}
+
+// usquare computes the full 512-bit square of x. Each product of two
+// different words appears twice in the square, so it is computed once and
+// doubled, taking 10 word multiplications instead of the 16 of umul.
+func usquare(x *Int) [8]uint64 {
+	var (
+		res   [8]uint64
+		carry uint64
+		h, l  uint64
+	)
+	// Products x[i]*x[j] for i < j.
+	carry, res[1] = bits.Mul64(x[0], x[1])
+	carry, res[2] = umulHop(carry, x[0], x[2])
+	carry, res[3] = umulHop(carry, x[0], x[3])
+	res[4] = carry
+
+	carry, res[3] = umulHop(res[3], x[1], x[2])
+	carry, res[4] = umulStep(res[4], x[1], x[3], carry)
+	res[5] = carry
+
+	carry, res[5] = umulHop(res[5], x[2], x[3])
+	res[6] = carry
+
+	// Double them.
+	res[7] = res[6] >> 63
+	res[6] = res[6]<<1 | res[5]>>63
+	res[5] = res[5]<<1 | res[4]>>63
+	res[4] = res[4]<<1 | res[3]>>63
+	res[3] = res[3]<<1 | res[2]>>63
+	res[2] = res[2]<<1 | res[1]>>63
+	res[1] = res[1] << 1
+
+	// Add the squares x[i]*x[i].
+	h, res[0] = bits.Mul64(x[0], x[0])
+	res[1], carry = bits.Add64(res[1], h, 0)
+	h, l = bits.Mul64(x[1], x[1])
+	res[2], carry = bits.Add64(res[2], l, carry)
+	res[3], carry = bits.Add64(res[3], h, carry)
+	h, l = bits.Mul64(x[2], x[2])
+	res[4], carry = bits.Add64(res[4], l, carry)
+	res[5], carry = bits.Add64(res[5], h, carry)
+	h, l = bits.Mul64(x[3], x[3])
+	res[6], carry = bits.Add64(res[6], l, carry)
+	res[7], _ = bits.Add64(res[7], h, carry)
+	return res
+}
+
 func (z *Int) squared() {
 	var (
 		res                    Int
@@ -453,9 +586,9 @@ func subMulTo(x, y []uint64, multiplier uint64) uint64 {
 }
 
 // udivremBy1 divides u by single normalized word d and produces both quotient and remainder.
+// It uses the provided d's reciprocal.
 // The quotient is stored in provided quot.
-func udivremBy1(quot, u []uint64, d uint64) (rem uint64) {
-	reciprocal := reciprocal2by1(d)
+func udivremBy1(quot, u []uint64, d, reciprocal uint64) (rem uint64) {
 	rem = u[len(u)-1] // Set the top word as remainder.
 	for j := len(u) - 2; j >= 0; j-- {
 		quot[j], rem = udivrem2by1(rem, u[j], d, reciprocal)
@@ -463,13 +596,40 @@ func udivremBy1(quot, u []uint64, d uint64) (rem uint64) {
 	return rem
 }
 
+// udivremUint64 divides x by d != 0, stores the quotient in quot and
+// returns the remainder. The quot may alias x.
+func udivremUint64(quot, x *Int, d uint64) uint64 {
+	if x.IsUint64() {
+		q, r := x[0]/d, x[0]%d
+		quot.SetUint64(q)
+		return r
+	}
+	n := 4
+	for x[n-1] == 0 {
+		n--
+	}
+	shift := uint(bits.LeadingZeros64(d))
+	var un [5]uint64
+	un[n] = x[n-1] >> (64 - shift)
+	for i := n - 1; i > 0; i-- {
+		un[i] = (x[i] << shift) | (x[i-1] >> (64 - shift))
+	}
+	un[0] = x[0] << shift
+	dn := d << shift
+	rem := udivremBy1(quot[:], un[:n+1], dn, reciprocal2by1(dn))
+	for i := n; i < 4; i++ {
+		quot[i] = 0
+	}
+	return rem >> shift
+}
+
 // udivremKnuth implements the division of u by normalized multiple word d from the Knuth's division algorithm.
 // The quotient is stored in provided quot - len(u)-len(d) words.
 // Updates u to contain the remainder - len(d) words.
-func udivremKnuth(quot, u, d []uint64) {
+// It uses the provided reciprocal of the top word of d.
+func udivremKnuth(quot, u, d []uint64, reciprocal uint64) {
 	dh := d[len(d)-1]
 	dl := d[len(d)-2]
-	reciprocal := reciprocal2by1(dh)
 
 	for j := len(u) - len(d) - 1; j >= 0; j-- {
 		u2 := u[j+len(d)]
@@ -536,6 +696,16 @@ func udivrem(quot, u []uint64, d *Int) (rem Int) {
 		return rem
 	}
 
+	return udivremNormalized(quot, u[:uLen], dn, shift, reciprocal2by1(dn[dLen-1]))
+}
+
+// udivremNormalized divides u by d, given as dn = d << shift normalized so
+// that the top bit of its top word is set, and the reciprocal of that word.
+// The top word of u must be non-zero, and u no shorter than dn.
+// The quotient is stored in provided quot - len(u)-len(dn)+1 words.
+func udivremNormalized(quot, u, dn []uint64, shift uint, reciprocal uint64) (rem Int) {
+	uLen, dLen := len(u), len(dn)
+
 	var unStorage [9]uint64
 	un := unStorage[:uLen+1]
 	un[uLen] = u[uLen-1] >> (64 - shift)
@@ -547,12 +717,12 @@ func udivrem(quot, u []uint64, d *Int) (rem Int) {
 	// TODO: Skip the highest word of numerator if not significant.
 
 	if dLen == 1 {
-		r := udivremBy1(quot, un, dn[0])
+		r := udivremBy1(quot, un, dn[0], reciprocal)
 		rem.SetUint64(r >> shift)
 		return rem
 	}
 
-	udivremKnuth(quot, un, dn)
+	udivremKnuth(quot, un, dn, reciprocal)
 
 	for i := 0; i < dLen-1; i++ {
 		rem[i] = (un[i] >> shift) | (un[i+1] << (64 - shift))
@@ -627,6 +797,36 @@ func (z *Int) DivMod(x, y, m *Int) (*Int, *Int) {
 	return z, m
 }
 
+// DivUint64 sets z to the quotient x/d, where d is a uint64, and returns z.
+// If d == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) DivUint64(x *Int, d uint64) *Int {
+	if d == 0 {
+		return z.Clear()
+	}
+	udivremUint64(z, x, d)
+	return z
+}
+
+// ModUint64 returns the modulus z%d, where d is a uint64.
+// If d == 0, it returns 0 (OBS: differs from the big.Int)
+func (z *Int) ModUint64(d uint64) uint64 {
+	if d == 0 {
+		return 0
+	}
+	var quot Int
+	return udivremUint64(&quot, z, d)
+}
+
+// DivModUint64 sets z to the quotient x div d, where d is a uint64, and
+// returns z and the modulus x mod d.
+// If d == 0, z is set to 0 and the modulus is 0 (OBS: differs from the big.Int)
+func (z *Int) DivModUint64(x *Int, d uint64) (*Int, uint64) {
+	if d == 0 {
+		return z.Clear(), 0
+	}
+	return z, udivremUint64(z, x, d)
+}
+
 // SMod interprets x and y as two's complement signed integers,
 // sets z to (sign x) * { abs(x) modulus abs(y) }
 // If y == 0, z is set to 0 (OBS: differs from the big.Int)
@@ -664,6 +864,13 @@ func (z *Int) MulModWithReciprocal(x, y, m *Int, mu *[5]uint64) *Int {
 		return z.Set(&r)
 	}
 
+	// A reciprocal of a k-word modulus has its word k set; if it is unset,
+	// mu was not computed for m, so fall back to division.
+	if k := (m.BitLen() + 63) / 64; mu[k] != 0 {
+		r := reduceSmall(&p, m, mu, k)
+		return z.Set(&r)
+	}
+
 	var (
 		pl Int
 		ph Int
@@ -689,10 +896,14 @@ func (z *Int) MulMod(x, y, m *Int) *Int {
 		return z.Clear()
 	}
 	p := umul(x, y)
+	return z.mod512(&p, m)
+}
 
+// mod512 sets z to the 512-bit product p modulo the non-zero m, and returns z.
+func (z *Int) mod512(p *[8]uint64, m *Int) *Int {
 	if m[3] != 0 {
 		mu := Reciprocal(m)
-		r := reduce4(p, m, mu)
+		r := reduce4(*p, m, mu)
 		return z.Set(&r)
 	}
 
@@ -713,6 +924,77 @@ func (z *Int) MulMod(x, y, m *Int) *Int {
 	return z.Set(&rem)
 }
 
+// SqrMod calculates the modulo-m square of x and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) SqrMod(x, m *Int) *Int {
+	if x.IsZero() || m.IsZero() {
+		return z.Clear()
+	}
+	// The square of x < 2^128 fits in 256 bits, where squared computes it.
+	if x[2]|x[3] == 0 {
+		sq := *x
+		sq.squared()
+		return z.Mod(&sq, m)
+	}
+	p := usquare(x)
+	return z.mod512(&p, m)
+}
+
+// LshMod sets z to x*2^n mod m, and returns z.
+// If m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) LshMod(x *Int, n uint, m *Int) *Int {
+	if x.IsZero() || m.IsZero() {
+		return z.Clear()
+	}
+	if n >= 256 {
+		var two, e, p Int
+		two.SetUint64(2)
+		e.SetUint64(uint64(n))
+		p.ExpMod(&two, &e, m)
+		return z.MulMod(x, &p, m)
+	}
+	// x << n fits in 512 bits, so reduce it at once.
+	var (
+		u    [8]uint64
+		quot [8]uint64
+		w, s = n / 64, n % 64
+	)
+	for i := uint(0); i < 4; i++ {
+		u[i+w] |= x[i] << s
+		if s != 0 {
+			u[i+w+1] = x[i] >> (64 - s)
+		}
+	}
+	rem := udivrem(quot[:], u[:], m)
+	return z.Set(&rem)
+}
+
+// HalveMod sets z to x/2 mod m, that is the residue r with 2r = x mod m, and
+// returns z. Halving is only defined for odd m.
+// If m is even, including m == 0, z is set to 0 (OBS: differs from the big.Int)
+func (z *Int) HalveMod(x, m *Int) *Int {
+	if m[0]&1 == 0 {
+		return z.Clear()
+	}
+	var (
+		r     Int
+		carry uint64
+	)
+	r.Mod(x, m)
+	// An odd r becomes the even r+m, which may carry into bit 256.
+	if r[0]&1 != 0 {
+		r[0], carry = bits.Add64(r[0], m[0], 0)
+		r[1], carry = bits.Add64(r[1], m[1], carry)
+		r[2], carry = bits.Add64(r[2], m[2], carry)
+		r[3], carry = bits.Add64(r[3], m[3], carry)
+	}
+	z[0] = r[0]>>1 | r[1]<<63
+	z[1] = r[1]>>1 | r[2]<<63
+	z[2] = r[2]>>1 | r[3]<<63
+	z[3] = r[3]>>1 | carry<<63
+	return z
+}
+
 // MulDivOverflow calculates (x*y)/d with full precision, returns z and whether overflow occurred in multiply process (result does not fit to 256-bit).
 // computes 512-bit multiplication and 512 by 256 division.
 func (z *Int) MulDivOverflow(x, y, d *Int) (*Int, bool) {
@@ -748,6 +1030,13 @@ func (z *Int) Neg(x *Int) *Int {
 	return z.Sub(new(Int), x)
 }
 
+// NegOverflow sets z to -x mod 2**256, and returns z and whether overflow
+// occurred, that is, whether x is non-zero.
+func (z *Int) NegOverflow(x *Int) (*Int, bool) {
+	overflow := !x.IsZero()
+	return z.Neg(x), overflow
+}
+
 // SDiv interprets n and d as two's complement signed integers,
 // does a signed division on the two operands and sets z to the result.
 // If d == 0, z is set to 0
@@ -932,6 +1221,26 @@ func (z *Int) Cmp(x *Int) (r int) {
 	return 1
 }
 
+// CmpUint64 compares z and n and returns:
+//
+//	-1 if z <  n
+//	 0 if z == n
+//	+1 if z >  n
+func (z *Int) CmpUint64(n uint64) int {
+	if z.LtUint64(n) {
+		return -1
+	}
+	if z.EqUint64(n) {
+		return 0
+	}
+	return 1
+}
+
+// EqUint64 returns true if z == n
+func (z *Int) EqUint64(n uint64) bool {
+	return z[0] == n && (z[1]|z[2]|z[3]) == 0
+}
+
 // LtUint64 returns true if z is smaller than n
 func (z *Int) LtUint64(n uint64) bool {
 	return z[0] < n && (z[1]|z[2]|z[3]) == 0
@@ -1029,6 +1338,13 @@ sh192:
 	return z
 }
 
+// LshOverflow sets z = x << n, and returns z and whether overflow occurred,
+// that is, whether any set bits were shifted out.
+func (z *Int) LshOverflow(x *Int, n uint) (*Int, bool) {
+	overflow := !x.IsZero() && (n >= 256 || uint(x.BitLen())+n > 256)
+	return z.Lsh(x, n), overflow
+}
+
 // Rsh sets z = x >> n and returns z.
 func (z *Int) Rsh(x *Int, n uint) *Int {
 	// n % 64 == 0
@@ -1246,6 +1562,56 @@ func (z *Int) Exp(base, exponent *Int) *Int {
 	return z.Set(&res)
 }
 
+// ExpOverflow sets z = base**exponent mod 2**256, and returns z and whether
+// overflow occurred.
+func (z *Int) ExpOverflow(base, exponent *Int) (*Int, bool) {
+	switch {
+	case base.LtUint64(2):
+		// 0**0 == 1, 0**e == 0 and 1**e == 1
+		return z.Exp(base, exponent), false
+	case !exponent.LtUint64(256):
+		return z.Exp(base, exponent), true // at least 2**256
+	}
+	return z.expOverflow(base, uint(exponent[0]))
+}
+
+// ExpMod sets z = base**exponent mod m, and returns z.
+// As with (*big.Int).Exp, base**0 mod m is 1 for m > 1, and anything
+// mod 1 is 0. If m == 0, z is set to base**exponent mod 2**256, i.e. the
+// result of (*big.Int).Exp with a nil modulus, truncated to 256 bits
+// (OBS: differs from the other modular operations, which return 0).
+func (z *Int) ExpMod(base, exponent, m *Int) *Int {
+	if m.IsZero() {
+		return z.Exp(base, exponent)
+	}
+	if m.IsUint64() {
+		var b Int
+		return z.SetUint64(expMod64(b.Mod(base, m).Uint64(), exponent, m.Uint64()))
+	}
+	md := Modulus{m: *m, mu: Reciprocal(m), k: (m.BitLen() + 63) / 64}
+	return md.Exp(z, base, exponent)
+}
+
+// expMod64 returns base**exponent mod m, for base < m.
+func expMod64(base uint64, exponent *Int, m uint64) uint64 {
+	res := 1 % m
+	for i := exponent.BitLen() - 1; i >= 0; i-- {
+		hi, lo := bits.Mul64(res, res)
+		res = bits.Rem64(hi, lo, m)
+		if exponent.isBitSet(uint(i)) {
+			hi, lo = bits.Mul64(res, base)
+			res = bits.Rem64(hi, lo, m)
+		}
+	}
+	return res
+}
+
+// window4 returns the i'th 4-bit window of z, counting from the least
+// significant bits.
+func (z *Int) window4(i int) uint64 {
+	return (z[i/16] >> (uint(i%16) * 4)) & 0xf
+}
+
 // ExtendSign extends length of two’s complement signed integer,
 // sets z to
 //   - x if byteNum > 31
@@ -1271,34 +1637,41 @@ func (z *Int) ExtendSign(x, byteNum *Int) *Int {
 
 // Sqrt sets z to ⌊√x⌋, the largest integer such that z² ≤ x, and returns z.
 func (z *Int) Sqrt(x *Int) *Int {
-	// This implementation of Sqrt is based on big.Int (see math/big/nat.go).
-	if x.LtUint64(2) {
-		return z.Set(x)
+	if x.IsUint64() {
+		return z.SetUint64(sqrt64(x[0]))
+	}
+	// Newton iteration "y = ⌊(y + ⌊x/y⌋)/2⌋" never goes below ⌊√x⌋, and
+	// doubles the number of correct bits. Starting from a floating point
+	// overestimate with 40 correct bits, one step is enough for roots of up
+	// to 75 bits, and two steps for the rest. The result is then at most
+	// slightly above ⌊√x⌋, which is fixed by squaring.
+	var y, t Int
+	y.rootEstimate(x, 2)
+	steps := 1
+	if x.BitLen() > 150 {
+		steps = 2
+	}
+	for i := 0; i < steps; i++ {
+		t.Div(x, &y)
+		t.Add(&t, &y)
+		y.Rsh(&t, 1)
 	}
-	var (
-		z1 = &Int{1, 0, 0, 0}
-		z2 = &Int{}
-	)
-	// Start with value known to be too large and repeat "z = ⌊(z + ⌊x/z⌋)/2⌋" until it stops getting smaller.
-	z1 = z1.Lsh(z1, uint(x.BitLen()+1)/2) // must be ≥ √x
 	for {
-		z2 = z2.Div(x, z1)
-		z2 = z2.Add(z2, z1)
-		{ //z2 = z2.Rsh(z2, 1) -- the code below does a 1-bit rsh faster
-			a := z2[3] << 63
-			z2[3] = z2[3] >> 1
-			b := z2[2] << 63
-			z2[2] = (z2[2] >> 1) | a
-			a = z2[1] << 63
-			z2[1] = (z2[1] >> 1) | b
-			z2[0] = (z2[0] >> 1) | a
+		if _, overflow := t.MulOverflow(&y, &y); !overflow && !x.Lt(&t) {
+			return z.Set(&y)
 		}
-		// end of inlined bitshift
+		y.SubUint64(&y, 1)
+	}
+}
 
-		if z2.Cmp(z1) >= 0 {
-			// z1 is answer.
-			return z.Set(z1)
-		}
-		z1, z2 = z2, z1
+// sqrt64 returns ⌊√x⌋.
+func sqrt64(x uint64) uint64 {
+	// The floating point root is off by at most one.
+	r := uint64(math.Sqrt(float64(x)))
+	if hi, lo := bits.Mul64(r, r); hi != 0 || lo > x {
+		r--
+	} else if hi, lo = bits.Mul64(r+1, r+1); hi == 0 && lo <= x {
+		r++
 	}
+	return r
 }
@@ -264,6 +264,56 @@ func TestRandomBinOp(t *testing.T) {
 	t.Run("DivMod/Mod", func(t *testing.T) { testRandomOp(t, divModMod, bigMod) })
 	t.Run("udivrem/Div", func(t *testing.T) { testRandomOp(t, udivremDiv, bigDiv) })
 	t.Run("udivrem/Mod", func(t *testing.T) { testRandomOp(t, udivremMod, bigMod) })
+	t.Run("MulUint64", func(t *testing.T) { testRandomOp(t, mulUint64, bigUint64Op((*big.Int).Mul)) })
+	t.Run("DivUint64", func(t *testing.T) { testRandomOp(t, divUint64, bigUint64Op(bigDiv)) })
+	t.Run("ModUint64", func(t *testing.T) { testRandomOp(t, modUint64, bigUint64Op(bigMod)) })
+	t.Run("DivModUint64/Div", func(t *testing.T) { testRandomOp(t, divModUint64Div, bigUint64Op(bigDiv)) })
+	t.Run("DivModUint64/Mod", func(t *testing.T) { testRandomOp(t, divModUint64Mod, bigUint64Op(bigMod)) })
+	t.Run("NegMod", func(t *testing.T) { testRandomOp(t, (*Int).NegMod, bigNegMod) })
+	t.Run("SqrMod", func(t *testing.T) { testRandomOp(t, (*Int).SqrMod, bigSqrMod) })
+	t.Run("HalveMod", func(t *testing.T) { testRandomOp(t, (*Int).HalveMod, bigHalveMod) })
+	t.Run("AddSat", func(t *testing.T) { testRandomOp(t, (*Int).AddSat, bigAddSat) })
+	t.Run("SubSat", func(t *testing.T) { testRandomOp(t, (*Int).SubSat, bigSubSat) })
+	t.Run("MulSat", func(t *testing.T) { testRandomOp(t, (*Int).MulSat, bigMulSat) })
+	t.Run("ExpSat", func(t *testing.T) { testRandomOp(t, (*Int).ExpSat, bigExpSat) })
+	t.Run("SAddSat", func(t *testing.T) { testRandomOp(t, (*Int).SAddSat, bigSAddSat) })
+	t.Run("SSubSat", func(t *testing.T) { testRandomOp(t, (*Int).SSubSat, bigSSubSat) })
+}
+
+// TestExpLshSat checks ExpSat and LshSat around the overflow boundary, for
+// exponents and shifts which random operands rarely hit.
+func TestExpLshSat(t *testing.T) {
+	var xs []*Int
+	for _, x := range []uint64{0, 1, 2, 3, 255, 1 << 32, ^uint64(0)} {
+		xs = append(xs, NewInt(x))
+	}
+	for _, n := range []uint{64, 127, 128, 200, 255} {
+		xs = append(xs, new(Int).Lsh(NewInt(1), n), new(Int).Mask(n))
+	}
+	xs = append(xs, new(Int).SetAllOne())
+	var ns []uint
+	for n := uint(0); n <= 300; n++ {
+		ns = append(ns, n)
+	}
+	ns = append(ns, 1<<16, ^uint(0))
+	for _, x := range xs {
+		bx := x.ToBig()
+		for _, n := range ns {
+			bn := new(big.Int).SetUint64(uint64(n))
+			if have, want := new(Int).ExpSat(x, NewInt(uint64(n))), bigExpSat(new(big.Int), bx, bn); !checkEq(want, have) {
+				t.Fatalf("ExpSat(%#x, %d): have %#x want %#x", x, n, have, want)
+			}
+			if have, want := new(Int).LshSat(x, n), bigLshSat(new(big.Int), bx, bn); !checkEq(want, have) {
+				t.Fatalf("LshSat(%#x, %d): have %#x want %#x", x, n, have, want)
+			}
+		}
+		// Exponents >= 256 with low bits clear, which the exponentiation
+		// over the low 8 bits alone would take for 0.
+		e := new(Int).Lsh(NewInt(1), 200)
+		if have, want := new(Int).ExpSat(x, e), bigExpSat(new(big.Int), bx, e.ToBig()); !checkEq(want, have) {
+			t.Fatalf("ExpSat(%#x, %#x): have %#x want %#x", x, e, have, want)
+		}
+	}
 }
 
 func TestRandomMulOverflow(t *testing.T) {
@@ -288,6 +338,83 @@ func TestRandomMulOverflow(t *testing.T) {
 	}
 }
 
+func TestOverflowOps(t *testing.T) {
+	low64 := func(y *big.Int) *big.Int {
+		return new(big.Int).SetUint64(new(big.Int).And(y, new(big.Int).SetUint64(^uint64(0))).Uint64())
+	}
+	// The big.Int references give the exact result, except for Exp, where a
+	// result of at least 2**256 with the correct low bits is used.
+	ops := []struct {
+		name  string
+		op    func(z, x, y *Int) (*Int, bool)
+		bigOp func(x, y *big.Int) *big.Int
+	}{
+		{"AddUint64Overflow",
+			func(z, x, y *Int) (*Int, bool) { return z.AddUint64Overflow(x, y[0]) },
+			func(x, y *big.Int) *big.Int { return new(big.Int).Add(x, low64(y)) }},
+		{"SubUint64Overflow",
+			func(z, x, y *Int) (*Int, bool) { return z.SubUint64Overflow(x, y[0]) },
+			func(x, y *big.Int) *big.Int { return new(big.Int).Sub(x, low64(y)) }},
+		{"MulUint64Overflow",
+			func(z, x, y *Int) (*Int, bool) { return z.MulUint64Overflow(x, y[0]) },
+			func(x, y *big.Int) *big.Int { return new(big.Int).Mul(x, low64(y)) }},
+		{"NegOverflow",
+			func(z, x, y *Int) (*Int, bool) { return z.NegOverflow(x) },
+			func(x, y *big.Int) *big.Int { return new(big.Int).Neg(x) }},
+		{"LshOverflow",
+			func(z, x, y *Int) (*Int, bool) { return z.LshOverflow(x, uint(y[0]%300)) },
+			func(x, y *big.Int) *big.Int { return new(big.Int).Lsh(x, uint(low64(y).Uint64()%300)) }},
+		{"ExpOverflow",
+			(*Int).ExpOverflow,
+			func(x, y *big.Int) *big.Int {
+				if x.Cmp(big.NewInt(1)) > 0 && y.Cmp(big.NewInt(256)) >= 0 {
+					res := bigExp(new(big.Int), new(big.Int).Set(x), y)
+					return res.Add(res, bigtt256)
+				}
+				return new(big.Int).Exp(x, y, nil)
+			}},
+	}
+	check := func(t *testing.T, x, y *Int) {
+		t.Helper()
+		for _, tc := range ops {
+			want := tc.bigOp(x.ToBig(), y.ToBig())
+			wantOverflow := want.Sign() < 0 || want.Cmp(tt256m1) > 0
+			z := new(Int)
+			res, overflow := tc.op(z, x, y)
+			if res != z {
+				t.Fatalf("%s: unexpected pointer returned: %p, expected: %p", tc.name, res, z)
+			}
+			if !checkEq(want, z) || overflow != wantOverflow {
+				t.Fatalf("%s(%#x, %#x): have %#x, %v want %#x, %v", tc.name, x, y, z, overflow, u256(want), wantOverflow)
+			}
+			// Check if reusing args as result works correctly.
+			z = x.Clone()
+			if _, overflow := tc.op(z, z, y); !checkEq(want, z) || overflow != wantOverflow {
+				t.Fatalf("%s(%#x, %#x) with z == x: have %#x, %v want %#x, %v", tc.name, x, y, z, overflow, u256(want), wantOverflow)
+			}
+		}
+	}
+	for _, tc := range binTestCases {
+		b1, _ := new(big.Int).SetString(tc[0], 0)
+		b2, _ := new(big.Int).SetString(tc[1], 0)
+		x, _ := FromBig(b1)
+		y, _ := FromBig(b2)
+		check(t, x, y)
+	}
+	for i := 0; i < 10000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, y, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, x, y)
+		check(t, x, new(Int).Rsh(y, 248))
+	}
+}
+
 func TestRandomSquare(t *testing.T) {
 	testRandomOp(t,
 		func(f1, f2, f3 *Int) *Int {
@@ -300,6 +427,22 @@ func TestRandomSquare(t *testing.T) {
 	)
 }
 
+func TestRandomUsquare(t *testing.T) {
+	max := new(Int).SetAllOne()
+	if have, want := usquare(max), umul(max, max); have != want {
+		t.Fatalf("usquare(%#x): have %x want %x", max, have, want)
+	}
+	for i := 0; i < 10000; i++ {
+		_, x, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := usquare(x), umul(x, x); have != want {
+			t.Fatalf("usquare(%#x): have %x want %x", x, have, want)
+		}
+	}
+}
+
 // divModDiv wraps DivMod and returns quotient only
 func divModDiv(z, x, y *Int) *Int {
 	var m Int
@@ -332,6 +475,41 @@ func udivremMod(z, x, y *Int) *Int {
 	return z.Set(&rem)
 }
 
+// The uint64 operand variants below take the low word of y as the operand,
+// and are checked against bigUint64Op of the full-width operation.
+
+func mulUint64(z, x, y *Int) *Int {
+	return z.MulUint64(x, y[0])
+}
+
+func divUint64(z, x, y *Int) *Int {
+	return z.DivUint64(x, y[0])
+}
+
+func modUint64(z, x, y *Int) *Int {
+	return z.SetUint64(x.ModUint64(y[0]))
+}
+
+// divModUint64Div wraps DivModUint64 and returns quotient only
+func divModUint64Div(z, x, y *Int) *Int {
+	z.DivModUint64(x, y[0])
+	return z
+}
+
+// divModUint64Mod wraps DivModUint64 and returns modulus only
+func divModUint64Mod(z, x, y *Int) *Int {
+	_, rem := new(Int).DivModUint64(x, y[0])
+	return z.SetUint64(rem)
+}
+
+// bigUint64Op returns op with its second operand truncated to 64 bits.
+func bigUint64Op(op func(z, x, y *big.Int) *big.Int) func(z, x, y *big.Int) *big.Int {
+	return func(z, x, y *big.Int) *big.Int {
+		low := new(big.Int).And(y, new(big.Int).SetUint64(^uint64(0)))
+		return op(z, x, low)
+	}
+}
+
 func TestRandomSqrt(t *testing.T) {
 	testRandomOp(t,
 		func(f1, f2, f3 *Int) *Int {
@@ -392,6 +570,20 @@ func TestRandomMulMod(t *testing.T) {
 		if !checkEq(b1, f1) {
 			t.Fatalf("Expected equality:\nf2= %x\nf3= %x\nf4= %x\n[ op ]==\nf = %x\nb = %x\n", f2, f3, f4, f1, b1)
 		}
+
+		f1.SubMod(f2, f3, f4)
+		bigSubMod(b1, b2, b3, b4)
+
+		if !checkEq(b1, f1) {
+			t.Fatalf("Expected equality:\nf2= %x\nf3= %x\nf4= %x\n[ SubMod ]==\nf = %x\nb = %x\n", f2, f3, f4, f1, b1)
+		}
+
+		f1.lshModWrapper(f2, f3, f4)
+		bigLshMod(b1, b2, b3, b4)
+
+		if !checkEq(b1, f1) {
+			t.Fatalf("Expected equality:\nf2= %x\nf3= %x\nf4= %x\n[ LshMod ]==\nf = %x\nb = %x\n", f2, f3, f4, f1, b1)
+		}
 	}
 
 	// Tests related to powers of 2
@@ -1102,6 +1294,56 @@ func bigSMod(result, x, y *big.Int) *big.Int {
 	return u256(result)
 }
 
+// bigClamp clamps z to the range [0, 2^256-1].
+func bigClamp(z *big.Int) *big.Int {
+	if z.Sign() < 0 {
+		return z.SetUint64(0)
+	}
+	if z.Cmp(tt256m1) > 0 {
+		return z.Set(tt256m1)
+	}
+	return z
+}
+
+// bigSClamp clamps z to the range [-2^255, 2^255-1], and encodes it as a 256
+// bit two's complement number.
+func bigSClamp(z *big.Int) *big.Int {
+	if z.Cmp(bigtt255) >= 0 {
+		z.Sub(bigtt255, big.NewInt(1))
+	} else if z.Cmp(new(big.Int).Neg(bigtt255)) < 0 {
+		z.Neg(bigtt255)
+	}
+	return u256(z)
+}
+
+func bigAddSat(z, x, y *big.Int) *big.Int { return bigClamp(z.Add(x, y)) }
+func bigSubSat(z, x, y *big.Int) *big.Int { return bigClamp(z.Sub(x, y)) }
+func bigMulSat(z, x, y *big.Int) *big.Int { return bigClamp(z.Mul(x, y)) }
+
+func bigExpSat(z, x, y *big.Int) *big.Int {
+	if x.Cmp(big.NewInt(1)) > 0 && y.Cmp(big.NewInt(256)) >= 0 {
+		return z.Set(tt256m1) // avoid a huge exponentiation
+	}
+	return bigClamp(z.Exp(x, y, nil))
+}
+
+func bigLshSat(z, x, y *big.Int) *big.Int {
+	if x.Sign() != 0 && y.Cmp(big.NewInt(256)) >= 0 {
+		return z.Set(tt256m1)
+	}
+	return bigClamp(z.Lsh(x, uint(y.Uint64())))
+}
+
+func bigSAddSat(z, x, y *big.Int) *big.Int {
+	sx, sy := S256(new(big.Int).Set(x)), S256(new(big.Int).Set(y))
+	return bigSClamp(z.Add(sx, sy))
+}
+
+func bigSSubSat(z, x, y *big.Int) *big.Int {
+	sx, sy := S256(new(big.Int).Set(x)), S256(new(big.Int).Set(y))
+	return bigSClamp(z.Sub(sx, sy))
+}
+
 func bigAddMod(result, x, y, mod *big.Int) *big.Int {
 	if mod.Sign() == 0 {
 		return result.SetUint64(0)
@@ -1116,6 +1358,60 @@ func bigMulMod(result, x, y, mod *big.Int) *big.Int {
 	return result.Mod(result.Mul(x, y), mod)
 }
 
+func bigSubMod(result, x, y, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Mod(result.Sub(x, y), mod)
+}
+
+func bigNegMod(result, x, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Mod(new(big.Int).Neg(x), mod)
+}
+
+func bigSqrMod(result, x, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	return result.Mod(new(big.Int).Mul(x, x), mod)
+}
+
+func bigHalveMod(result, x, mod *big.Int) *big.Int {
+	if mod.Bit(0) == 0 {
+		return result.SetUint64(0)
+	}
+	// x/2 = (x + x%2 * mod) / 2 for x < mod
+	r := new(big.Int).Mod(x, mod)
+	if r.Bit(0) == 1 {
+		r.Add(r, mod)
+	}
+	return result.Rsh(r, 1)
+}
+
+// lshModWrapper shifts by n mod 1024, to test both shifts within 512 bits
+// and larger ones.
+func (z *Int) lshModWrapper(x, n, mod *Int) *Int {
+	return z.LshMod(x, uint(n[0]%1024), mod)
+}
+
+func bigLshMod(result, x, n, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.SetUint64(0)
+	}
+	shift := uint(new(big.Int).Mod(n, big.NewInt(1024)).Uint64())
+	return result.Mod(result.Lsh(x, shift), mod)
+}
+
+func bigExpMod(result, base, exponent, mod *big.Int) *big.Int {
+	if mod.Sign() == 0 {
+		return result.Exp(base, exponent, bigtt256)
+	}
+	return result.Exp(base, exponent, mod)
+}
+
 func (z *Int) mulModWithReciprocalWrapper(x, y, mod *Int) *Int {
 	mu := Reciprocal(mod)
 	return z.MulModWithReciprocal(x, y, mod, &mu)
@@ -1152,6 +1448,38 @@ func TestRandomExp(t *testing.T) {
 	}
 }
 
+func TestRandomExpMod(t *testing.T) {
+	for i := 0; i < 2000; i++ {
+		b_base, base, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b_exp, exp, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b_mod, mod, err := randNums()
+		if err != nil {
+			t.Fatal(err)
+		}
+		f_res := new(Int).ExpMod(base, exp, mod)
+		b_res := bigExpMod(new(big.Int), b_base, b_exp, b_mod)
+		if !checkEq(b_res, f_res) {
+			t.Fatalf("Expected equality:\nbase= %x\nexp = %x\nmod = %x\n[ ^ ]==\nf = %x\nb = %x\n", base, exp, mod, f_res, b_res)
+		}
+	}
+	// Edge cases around the moduli 0 and 1 and the exponent 0.
+	for _, tc := range []struct{ base, exp, mod, want uint64 }{
+		{5, 0, 1, 0}, {0, 0, 1, 0}, {5, 3, 1, 0},
+		{5, 0, 7, 1}, {0, 0, 7, 1}, {0, 3, 7, 0},
+		{3, 2, 0, 9}, {0, 0, 0, 1},
+	} {
+		if have := new(Int).ExpMod(NewInt(tc.base), NewInt(tc.exp), NewInt(tc.mod)); !have.Eq(NewInt(tc.want)) {
+			t.Errorf("%d**%d mod %d: have %v want %d", tc.base, tc.exp, tc.mod, have, tc.want)
+		}
+	}
+}
+
 func TestUnOp(t *testing.T) {
 	proc := func(t *testing.T, op func(a, b *Int) *Int, bigOp func(a, b *big.Int) *big.Int) {
 		for i := 0; i < len(unTestCases); i++ {
@@ -1262,6 +1590,14 @@ func TestBinOp(t *testing.T) {
 	t.Run("DivMod/Mod", func(t *testing.T) { proc(t, divModMod, bigMod) })
 	t.Run("udivrem/Div", func(t *testing.T) { proc(t, udivremDiv, bigDiv) })
 	t.Run("udivrem/Mod", func(t *testing.T) { proc(t, udivremMod, bigMod) })
+	t.Run("MulUint64", func(t *testing.T) { proc(t, mulUint64, bigUint64Op((*big.Int).Mul)) })
+	t.Run("DivUint64", func(t *testing.T) { proc(t, divUint64, bigUint64Op(bigDiv)) })
+	t.Run("ModUint64", func(t *testing.T) { proc(t, modUint64, bigUint64Op(bigMod)) })
+	t.Run("DivModUint64/Div", func(t *testing.T) { proc(t, divModUint64Div, bigUint64Op(bigDiv)) })
+	t.Run("DivModUint64/Mod", func(t *testing.T) { proc(t, divModUint64Mod, bigUint64Op(bigMod)) })
+	t.Run("NegMod", func(t *testing.T) { proc(t, (*Int).NegMod, bigNegMod) })
+	t.Run("SqrMod", func(t *testing.T) { proc(t, (*Int).SqrMod, bigSqrMod) })
+	t.Run("HalveMod", func(t *testing.T) { proc(t, (*Int).HalveMod, bigHalveMod) })
 	t.Run("Exp", func(t *testing.T) { proc(t, (*Int).Exp, bigExp) })
 
 	t.Run("And", func(t *testing.T) { proc(t, (*Int).And, (*big.Int).And) })
@@ -1282,6 +1618,18 @@ func TestBinOp(t *testing.T) {
 			return z.Rsh(x, bigToShiftAmount(y))
 		})
 	})
+
+	t.Run("AddSat", func(t *testing.T) { proc(t, (*Int).AddSat, bigAddSat) })
+	t.Run("SubSat", func(t *testing.T) { proc(t, (*Int).SubSat, bigSubSat) })
+	t.Run("MulSat", func(t *testing.T) { proc(t, (*Int).MulSat, bigMulSat) })
+	t.Run("ExpSat", func(t *testing.T) { proc(t, (*Int).ExpSat, bigExpSat) })
+	t.Run("SAddSat", func(t *testing.T) { proc(t, (*Int).SAddSat, bigSAddSat) })
+	t.Run("SSubSat", func(t *testing.T) { proc(t, (*Int).SSubSat, bigSSubSat) })
+	t.Run("LshSat", func(t *testing.T) {
+		proc(t, func(z, x, y *Int) *Int {
+			return z.LshSat(x, toSatUint(y))
+		}, bigLshSat)
+	})
 }
 
 func TestTernOp(t *testing.T) {
@@ -1360,6 +1708,9 @@ func TestTernOp(t *testing.T) {
 	t.Run("AddMod", func(t *testing.T) { proc(t, (*Int).AddMod, bigAddMod) })
 	t.Run("MulMod", func(t *testing.T) { proc(t, (*Int).MulMod, bigMulMod) })
 	t.Run("MulModWithReciprocal", func(t *testing.T) { proc(t, (*Int).mulModWithReciprocalWrapper, bigMulMod) })
+	t.Run("ExpMod", func(t *testing.T) { proc(t, (*Int).ExpMod, bigExpMod) })
+	t.Run("SubMod", func(t *testing.T) { proc(t, (*Int).SubMod, bigSubMod) })
+	t.Run("LshMod", func(t *testing.T) { proc(t, (*Int).lshModWrapper, bigLshMod) })
 }
 
 func TestCmpOp(t *testing.T) {
@@ -1423,6 +1774,34 @@ func TestCmpOp(t *testing.T) {
 			return a.Cmp(new(big.Int).SetUint64(b.Uint64())) > 0
 		})
 	})
+	t.Run("EqUint64", func(t *testing.T) {
+		proc(t, func(a, b *Int) bool {
+			return a.EqUint64(b.Uint64())
+		}, func(a, b *big.Int) bool {
+			return a.Cmp(new(big.Int).SetUint64(b.Uint64())) == 0
+		})
+	})
+	t.Run("CmpUint64Eq", func(t *testing.T) {
+		proc(t, func(a, b *Int) bool {
+			return a.CmpUint64(b.Uint64()) == 0
+		}, func(a, b *big.Int) bool {
+			return a.Cmp(new(big.Int).SetUint64(b.Uint64())) == 0
+		})
+	})
+	t.Run("CmpUint64Lt", func(t *testing.T) {
+		proc(t, func(a, b *Int) bool {
+			return a.CmpUint64(b.Uint64()) < 0
+		}, func(a, b *big.Int) bool {
+			return a.Cmp(new(big.Int).SetUint64(b.Uint64())) < 0
+		})
+	})
+	t.Run("CmpUint64Gt", func(t *testing.T) {
+		proc(t, func(a, b *Int) bool {
+			return a.CmpUint64(b.Uint64()) > 0
+		}, func(a, b *big.Int) bool {
+			return a.Cmp(new(big.Int).SetUint64(b.Uint64())) > 0
+		})
+	})
 }
 
 // TestFixedExpReusedArgs tests the cases in Exp() where the arguments (including result) alias the same objects.